@@ -0,0 +1,11 @@
+# List all active API keys in a workspace
+data "anthropic_api_keys" "example" {
+  workspace_id = "wrkspc_xxxxx"
+  status       = "active"
+}
+
+# List API keys created by a user before a cutoff
+data "anthropic_api_keys" "stale" {
+  created_by_user_id = "user_xxxxx"
+  created_before     = "2025-01-01T00:00:00Z"
+}
@@ -0,0 +1,18 @@
+# Claude Code activity over a week
+data "anthropic_claude_code_usage" "example" {
+  starting_at = "2025-09-01"
+  ending_at   = "2025-09-08"
+}
+
+data "anthropic_users" "all" {}
+
+# Users with the claude_code_user role who never started a session
+output "unused_claude_code_seats" {
+  value = [
+    for u in data.anthropic_users.all.users : u.email
+    if u.role == "claude_code_user" && !contains([
+      for r in data.anthropic_claude_code_usage.example.records : r.email_address
+      if r.num_sessions > 0
+    ], u.email)
+  ]
+}
@@ -0,0 +1,14 @@
+# Daily costs per workspace
+data "anthropic_cost_report" "example" {
+  starting_at = "2025-01-01T00:00:00Z"
+  ending_at   = "2025-02-01T00:00:00Z"
+  group_by    = ["workspace_id", "description"]
+}
+
+# Total cost of a workspace in US dollars. Amounts are reported in cents.
+output "workspace_cost_usd" {
+  value = sum([
+    for r in data.anthropic_cost_report.example.results : tonumber(r.amount) / 100
+    if r.workspace_id == "wrkspc_xxxxx" && r.currency == "USD"
+  ])
+}
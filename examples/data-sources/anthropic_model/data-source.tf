@@ -0,0 +1,8 @@
+# Resolve a model alias to its concrete snapshot ID
+data "anthropic_model" "example" {
+  model = "claude-sonnet-4-5"
+}
+
+output "model_id" {
+  value = data.anthropic_model.example.id
+}
@@ -0,0 +1,9 @@
+data "anthropic_models" "all" {}
+
+# Validate that a pinned model is available to the organization
+check "model_available" {
+  assert {
+    condition     = contains([for m in data.anthropic_models.all.models : m.id], "claude-sonnet-4-5-20250929")
+    error_message = "The pinned model is not available."
+  }
+}
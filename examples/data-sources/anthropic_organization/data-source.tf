@@ -0,0 +1,9 @@
+data "anthropic_organization" "current" {}
+
+# Fail the plan if the Admin API key belongs to the wrong organization
+check "organization" {
+  assert {
+    condition     = data.anthropic_organization.current.id == "00000000-0000-0000-0000-000000000000"
+    error_message = "The configured Admin API key belongs to ${data.anthropic_organization.current.name}."
+  }
+}
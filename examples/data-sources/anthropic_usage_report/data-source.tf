@@ -0,0 +1,18 @@
+# Daily token usage per model and workspace
+data "anthropic_usage_report" "example" {
+  starting_at  = "2025-01-01T00:00:00Z"
+  ending_at    = "2025-02-01T00:00:00Z"
+  bucket_width = "1d"
+  group_by     = ["model", "workspace_id"]
+}
+
+# Usage of a single model in a workspace
+data "anthropic_usage_report" "filtered" {
+  starting_at   = "2025-01-01T00:00:00Z"
+  models        = ["claude-sonnet-4-5"]
+  workspace_ids = ["wrkspc_xxxxx"]
+}
+
+output "total_output_tokens" {
+  value = sum([for r in data.anthropic_usage_report.example.results : r.output_tokens])
+}
@@ -1,6 +1,31 @@
 # Configure the Anthropic provider
 provider "anthropic" {
   api_key = "sk-ant-REDACTED"
+
+  # Refuse to run if the API key belongs to a different organization
+  expected_organization_id = "00000000-0000-0000-0000-000000000000"
+
+  # Opt into Admin API beta features
+  beta_features = ["example-beta-2025-01-01"]
+
+  # Stay within the organization's rate limits
+  max_concurrent_requests = 4
+  requests_per_minute     = 600
+
+  # Send requests through an inspecting egress proxy with a private CA
+  proxy_url       = "http://proxy.example.com:3128"
+  ca_cert_file    = "/etc/ssl/certs/corporate-ca.pem"
+  request_timeout = "30s"
+
+  # Record every change made through the Admin API
+  audit_log_path = "anthropic-audit.jsonl"
+
+  # Retry rate limited and failed requests
+  retry {
+    max_attempts = 5
+    min_backoff  = "500ms"
+    max_backoff  = "1m"
+  }
 }
 
 # Create a new workspace
@@ -0,0 +1,5 @@
+# Import an existing API key
+terraform import anthropic_api_key.example api_key_id
+
+# Example
+terraform import anthropic_api_key.example apikey_xxxxx
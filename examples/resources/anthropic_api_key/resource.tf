@@ -0,0 +1,9 @@
+# Adopt an existing API key and manage its name and status
+resource "anthropic_api_key" "example" {
+  id     = "apikey_xxxxx"
+  name   = "Production"
+  status = "active"
+
+  # Deactivate the API key when this resource is destroyed
+  deactivate_on_destroy = true
+}
@@ -0,0 +1,6 @@
+import {
+  to = anthropic_organization_invite.example
+  identity = {
+    id = "invite_xxxxx"
+  }
+}
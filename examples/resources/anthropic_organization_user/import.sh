@@ -0,0 +1,5 @@
+# Import an existing organization user by ID
+terraform import anthropic_organization_user.example user_xxxxx
+
+# Import an existing organization user by email
+terraform import anthropic_organization_user.example developer@example.com
@@ -0,0 +1,11 @@
+# Manage the role of a user who has accepted an invite
+resource "anthropic_organization_user" "developer" {
+  email = "developer@example.com"
+  role  = "developer"
+}
+
+# Adopt a user by ID
+resource "anthropic_organization_user" "billing" {
+  user_id = "user_xxxxx"
+  role    = "billing"
+}
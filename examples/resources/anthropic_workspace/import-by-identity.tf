@@ -0,0 +1,6 @@
+import {
+  to = anthropic_workspace.example
+  identity = {
+    id = "wrkspc_xxxxx"
+  }
+}
@@ -0,0 +1,11 @@
+import {
+  to = anthropic_workspace.example
+  id = "wrkspc_xxxxx"
+}
+
+# Import an existing workspace by name. Archived workspaces are ignored, and
+# the name must match exactly one active workspace.
+import {
+  to = anthropic_workspace.production
+  id = "name:Production"
+}
@@ -3,3 +3,7 @@ terraform import anthropic_workspace.example workspace_id
 
 # Example
 terraform import anthropic_workspace.example wrkspc_xxxxx
+
+# Import an existing workspace by name. Archived workspaces are ignored, and
+# the name must match exactly one active workspace.
+terraform import anthropic_workspace.example "name:Production"
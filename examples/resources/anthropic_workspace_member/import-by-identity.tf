@@ -0,0 +1,7 @@
+import {
+  to = anthropic_workspace_member.example
+  identity = {
+    workspace_id = "wrkspc_xxxxx"
+    user_id      = "user_xxxxx"
+  }
+}
@@ -1,39 +1,102 @@
 package acctest
 
 import (
+	"cmp"
 	"context"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/jianyuan/go-utils/must"
 	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/fakeapi"
 )
 
 var (
-	TestApiKey = os.Getenv("ANTHROPIC_API_KEY")
-	TestUserId = os.Getenv("ANTHROPIC_TEST_USER_ID")
+	TestApiKey     = cmp.Or(os.Getenv("ANTHROPIC_ADMIN_API_KEY"), os.Getenv("ANTHROPIC_API_KEY"))
+	TestApiVersion = cmp.Or(os.Getenv("ANTHROPIC_API_VERSION"), apiclient.DefaultApiVersion)
+	TestUserId     = os.Getenv("ANTHROPIC_TEST_USER_ID")
+
+	// TestInferenceApiKey is a standard API key for the data sources that use
+	// the standard API. Tests that need it are skipped when it is not set.
+	TestInferenceApiKey = os.Getenv("ANTHROPIC_API_KEY")
+
+	// TestApiKeyId is the ID of an API key that tests may rename and
+	// deactivate. Tests that need it are skipped when it is not set.
+	TestApiKeyId = os.Getenv("ANTHROPIC_TEST_API_KEY_ID")
+
+	// TestFakeApi runs the acceptance tests against an in-memory fake of the
+	// API instead of the real one, so that they need no credentials.
+	TestFakeApi = os.Getenv("ANTHROPIC_TEST_FAKE_API") != ""
+
+	// FakeApi is the fake API that the acceptance tests run against, so that
+	// they can seed fixtures the provider cannot create, such as users. It
+	// is nil unless TestFakeApi is set.
+	FakeApi *fakeapi.Server
 
 	SharedClient *apiclient.ClientWithResponses
 )
 
 func init() {
+	if TestFakeApi {
+		startFakeApi()
+	}
+	useCassettes()
+
 	SharedClient = must.Get(apiclient.NewClientWithResponses(
-		"https://api.anthropic.com",
+		cmp.Or(os.Getenv("ANTHROPIC_BASE_URL"), "https://api.anthropic.com"),
+		apiclient.WithHTTPClient(&http.Client{Transport: Transport(http.DefaultTransport)}),
 		apiclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("anthropic-version", "2023-06-01")
+			req.Header.Set("anthropic-version", TestApiVersion)
 			req.Header.Set("x-api-key", TestApiKey)
 			return nil
 		}),
 	))
 }
 
+// startFakeApi starts the fake API and points the provider and the test
+// fixtures at it. The server lives as long as the test binary.
+func startFakeApi() {
+	FakeApi = fakeapi.New()
+	srv := httptest.NewServer(FakeApi)
+
+	// The provider reads its configuration from the environment.
+	os.Setenv("ANTHROPIC_BASE_URL", srv.URL)
+	os.Setenv("ANTHROPIC_ADMIN_API_KEY", fakeapi.AdminApiKey)
+	os.Setenv("ANTHROPIC_API_KEY", fakeapi.ApiKey)
+
+	TestApiKey = fakeapi.AdminApiKey
+	TestInferenceApiKey = fakeapi.ApiKey
+	TestUserId = fakeapi.UserId
+	TestApiKeyId = fakeapi.ApiKeyId
+}
+
 func PreCheck(t *testing.T) {
+	cassetteSession(t)
+
 	if TestApiKey == "" {
-		t.Fatal("ANTHROPIC_API_KEY must be set for acceptance tests")
+		t.Fatal("ANTHROPIC_ADMIN_API_KEY or ANTHROPIC_API_KEY must be set for acceptance tests")
 	}
 
 	if TestUserId == "" {
 		t.Fatal("ANTHROPIC_TEST_USER_ID must be set for acceptance tests")
 	}
 }
+
+func PreCheckApiKey(t *testing.T) {
+	PreCheck(t)
+
+	if TestApiKeyId == "" {
+		t.Skip("ANTHROPIC_TEST_API_KEY_ID must be set for API key acceptance tests")
+	}
+}
+
+func PreCheckInferenceApiKey(t *testing.T) {
+	PreCheck(t)
+
+	if TestInferenceApiKey == "" || strings.HasPrefix(TestInferenceApiKey, "sk-ant-admin") {
+		t.Skip("ANTHROPIC_API_KEY must be set to a standard API key for standard API acceptance tests")
+	}
+}
@@ -0,0 +1,105 @@
+package acctest
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jianyuan/go-utils/must"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/recorder"
+)
+
+// Placeholders stored in cassettes in place of the IDs of the objects that
+// the tests are run against.
+const (
+	cassetteUserId   = "user_cassette"
+	cassetteApiKeyId = "apikey_cassette"
+)
+
+var (
+	cassetteMode = must.Get(recorder.ModeFromEnv())
+
+	cassetteSessionsMu sync.Mutex
+	cassetteSessions   = make(map[string]*recorder.Session)
+)
+
+// useCassettes sets up the environment for recording or replaying cassettes.
+// Replayed tests need no credentials, so placeholders are used instead.
+func useCassettes() {
+	// Cassettes are meant to capture the real API, not the fake.
+	if cassetteMode == recorder.ModeRecord && TestFakeApi {
+		panic("ANTHROPIC_TEST_CASSETTE_MODE=record cannot be used with ANTHROPIC_TEST_FAKE_API")
+	}
+
+	if cassetteMode != recorder.ModeReplay {
+		return
+	}
+
+	os.Setenv("ANTHROPIC_ADMIN_API_KEY", "sk-ant-admin01-cassette")
+	os.Setenv("ANTHROPIC_API_KEY", "sk-ant-api03-cassette")
+
+	TestApiKey = "sk-ant-admin01-cassette"
+	TestInferenceApiKey = "sk-ant-api03-cassette"
+	TestUserId = cassetteUserId
+	TestApiKeyId = cassetteApiKeyId
+}
+
+// Transport returns next wrapped to record or replay the requests of the
+// running test in its cassette, or next itself if cassettes are not in use.
+func Transport(next http.RoundTripper) http.RoundTripper {
+	if cassetteMode == recorder.ModeOff {
+		return next
+	}
+	return recorder.Transport(next)
+}
+
+// cassetteSession returns the recorder session of the test, starting it on
+// first use, or nil if cassettes are not in use. Cassettes are stored in
+// testdata/cassettes/<test name>.json next to the tests. When replaying, tests
+// without a cassette are skipped.
+func cassetteSession(t *testing.T) *recorder.Session {
+	t.Helper()
+
+	// Without TF_ACC the acceptance tests are skipped and make no requests.
+	if cassetteMode == recorder.ModeOff || os.Getenv("TF_ACC") == "" {
+		return nil
+	}
+
+	cassetteSessionsMu.Lock()
+	defer cassetteSessionsMu.Unlock()
+
+	if s, ok := cassetteSessions[t.Name()]; ok {
+		return s
+	}
+
+	path := filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "__")+".json")
+	if _, err := os.Stat(path); cassetteMode == recorder.ModeReplay && errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("No cassette recorded at %s", path)
+	}
+
+	s, err := recorder.Start(path, cassetteMode, map[string]string{
+		TestUserId:   cassetteUserId,
+		TestApiKeyId: cassetteApiKeyId,
+	})
+	if err != nil {
+		t.Fatalf("Unable to start cassette: %s", err)
+	}
+
+	cassetteSessions[t.Name()] = s
+	t.Cleanup(func() {
+		cassetteSessionsMu.Lock()
+		delete(cassetteSessions, t.Name())
+		cassetteSessionsMu.Unlock()
+
+		if err := s.Stop(); err != nil {
+			t.Errorf("Unable to stop cassette: %s", err)
+		}
+	})
+
+	return s
+}
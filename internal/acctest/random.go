@@ -1,13 +1,25 @@
 package acctest
 
 import (
+	"fmt"
+	"testing"
+
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 )
 
-func RandInt() int {
+// RandInt returns a random integer. When recording or replaying, it is drawn
+// from the test's cassette so that replayed requests match recorded ones.
+func RandInt(t *testing.T) int {
+	t.Helper()
+
+	if s := cassetteSession(t); s != nil {
+		return s.Rand().Int()
+	}
 	return sdkacctest.RandInt()
 }
 
-func RandomWithPrefix(name string) string {
-	return sdkacctest.RandomWithPrefix(name)
+func RandomWithPrefix(t *testing.T, name string) string {
+	t.Helper()
+
+	return fmt.Sprintf("%s-%d", name, RandInt(t))
 }
@@ -0,0 +1,66 @@
+package apiclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Error types returned by the Anthropic API.
+const (
+	ErrorTypeInvalidRequest  = "invalid_request_error"
+	ErrorTypeAuthentication  = "authentication_error"
+	ErrorTypePermission      = "permission_error"
+	ErrorTypeNotFound        = "not_found_error"
+	ErrorTypeRequestTooLarge = "request_too_large"
+	ErrorTypeRateLimit       = "rate_limit_error"
+	ErrorTypeApi             = "api_error"
+	ErrorTypeOverloaded      = "overloaded_error"
+)
+
+// APIError is an unsuccessful response from the Anthropic API.
+type APIError struct {
+	StatusCode int
+	Type       string
+	Message    string
+	RequestId  string
+
+	// Body is the raw response body, kept for responses that do not follow
+	// the documented error format.
+	Body []byte
+}
+
+// NewAPIError decodes the error model from an unsuccessful response.
+func NewAPIError(httpResp *http.Response, body []byte) *APIError {
+	e := &APIError{
+		Body: body,
+	}
+
+	if httpResp != nil {
+		e.StatusCode = httpResp.StatusCode
+		e.RequestId = httpResp.Header.Get("request-id")
+	}
+
+	var model Error
+	if err := json.Unmarshal(body, &model); err == nil {
+		e.Type = model.Error.Type
+		e.Message = model.Error.Message
+	}
+
+	return e
+}
+
+func (e *APIError) Error() string {
+	var msg string
+	if e.Type != "" {
+		msg = fmt.Sprintf("%s (status code %d): %s", e.Type, e.StatusCode, e.Message)
+	} else {
+		msg = fmt.Sprintf("got status code %d: %s", e.StatusCode, string(e.Body))
+	}
+
+	if e.RequestId != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.RequestId)
+	}
+
+	return msg
+}
@@ -0,0 +1,77 @@
+package apiclient
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		requestId  string
+		body       string
+		want       APIError
+		wantError  string
+	}{
+		{
+			name:       "invalid request",
+			statusCode: http.StatusBadRequest,
+			requestId:  "req_test",
+			body:       `{"type":"error","error":{"type":"invalid_request_error","message":"name: Field required"}}`,
+			want:       APIError{StatusCode: http.StatusBadRequest, Type: ErrorTypeInvalidRequest, Message: "name: Field required", RequestId: "req_test"},
+			wantError:  "invalid_request_error (status code 400): name: Field required (request ID req_test)",
+		},
+		{
+			name:       "missing request ID",
+			statusCode: http.StatusNotFound,
+			body:       `{"type":"error","error":{"type":"not_found_error","message":"Not found"}}`,
+			want:       APIError{StatusCode: http.StatusNotFound, Type: ErrorTypeNotFound, Message: "Not found"},
+			wantError:  "not_found_error (status code 404): Not found",
+		},
+		{
+			name:       "non-JSON body",
+			statusCode: http.StatusBadGateway,
+			requestId:  "req_test",
+			body:       "<html>Bad Gateway</html>",
+			want:       APIError{StatusCode: http.StatusBadGateway, RequestId: "req_test"},
+			wantError:  "got status code 502: <html>Bad Gateway</html> (request ID req_test)",
+		},
+		{
+			name:       "empty body",
+			statusCode: http.StatusInternalServerError,
+			want:       APIError{StatusCode: http.StatusInternalServerError},
+			wantError:  "got status code 500: ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpResp := &http.Response{
+				StatusCode: tc.statusCode,
+				Header:     make(http.Header),
+			}
+			if tc.requestId != "" {
+				httpResp.Header.Set("request-id", tc.requestId)
+			}
+
+			got := NewAPIError(httpResp, []byte(tc.body))
+			if got.StatusCode != tc.want.StatusCode || got.Type != tc.want.Type || got.Message != tc.want.Message || got.RequestId != tc.want.RequestId {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+			if string(got.Body) != tc.body {
+				t.Errorf("got body %q, want %q", got.Body, tc.body)
+			}
+			if got.Error() != tc.wantError {
+				t.Errorf("got error %q, want %q", got.Error(), tc.wantError)
+			}
+		})
+	}
+}
+
+func TestNewAPIError_nilResponse(t *testing.T) {
+	got := NewAPIError(nil, []byte(`{"type":"error","error":{"type":"api_error","message":"Internal server error"}}`))
+	if got.StatusCode != 0 || got.RequestId != "" || got.Type != ErrorTypeApi {
+		t.Errorf("got %+v", got)
+	}
+}
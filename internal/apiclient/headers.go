@@ -0,0 +1,45 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// DefaultApiVersion is the value of the anthropic-version header sent when no
+// other version is configured.
+const DefaultApiVersion = "2023-06-01"
+
+// WithBetas returns a request editor that opts the request into the given
+// beta features, in addition to any already set on the request.
+func WithBetas(betas ...string) RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		AddBetas(req.Header, betas...)
+		return nil
+	}
+}
+
+// AddBetas adds the given beta features to the anthropic-beta header,
+// skipping any that are already present.
+func AddBetas(h http.Header, betas ...string) {
+	var values []string
+	add := func(beta string) {
+		if beta = strings.TrimSpace(beta); beta != "" && !slices.Contains(values, beta) {
+			values = append(values, beta)
+		}
+	}
+
+	for _, v := range h.Values("anthropic-beta") {
+		for beta := range strings.SplitSeq(v, ",") {
+			add(beta)
+		}
+	}
+	for _, beta := range betas {
+		add(beta)
+	}
+
+	if len(values) > 0 {
+		h.Set("anthropic-beta", strings.Join(values, ","))
+	}
+}
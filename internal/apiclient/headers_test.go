@@ -0,0 +1,24 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWithBetas(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://api.anthropic.com/v1/models", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	AddBetas(req.Header, "files-api-2025-04-14", "")
+	if err := WithBetas("skills-2025-10-02", "files-api-2025-04-14")(context.Background(), req); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := "files-api-2025-04-14,skills-2025-10-02"
+	if got := req.Header.Get("anthropic-beta"); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+var apiKeyStatuses = []string{"active", "inactive", "archived"}
+
+// AddApiKey adds an API key created by UserId to the organization and returns
+// its ID. An empty workspaceId adds the key to the default workspace.
+func (s *Server) AddApiKey(name, workspaceId string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	apiKey := &apiclient.ApiKey{
+		Id:             s.newId("apikey"),
+		Name:           name,
+		CreatedAt:      timestamp(time.Now()),
+		PartialKeyHint: new("sk-ant-api03-fak...eAA"),
+		Status:         "active",
+	}
+	if workspaceId != "" {
+		apiKey.WorkspaceId = new(workspaceId)
+	}
+	apiKey.CreatedBy.Id = UserId
+	apiKey.CreatedBy.Type = "user"
+	s.apiKeys = append(s.apiKeys, apiKey)
+
+	return apiKey.Id
+}
+
+func (s *Server) findApiKey(id string) int {
+	return slices.IndexFunc(s.apiKeys, func(k *apiclient.ApiKey) bool { return k.Id == id })
+}
+
+func (s *Server) listApiKeys(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	status := query.Get("status")
+	if status != "" && !slices.Contains(apiKeyStatuses, status) {
+		writeInvalidRequest(w, "status: Input should be 'active', 'inactive' or 'archived'")
+		return
+	}
+
+	var apiKeys []*apiclient.ApiKey
+	for _, k := range s.apiKeys {
+		if status != "" && k.Status != status {
+			continue
+		}
+		if v := query.Get("workspace_id"); v != "" && (k.WorkspaceId == nil || *k.WorkspaceId != v) {
+			continue
+		}
+		if v := query.Get("created_by_user_id"); v != "" && k.CreatedBy.Id != v {
+			continue
+		}
+		apiKeys = append(apiKeys, k)
+	}
+
+	writeList(w, r, apiKeys, func(k *apiclient.ApiKey) string { return k.Id })
+}
+
+func (s *Server) getApiKey(w http.ResponseWriter, r *http.Request) {
+	i := s.findApiKey(r.PathValue("api_key_id"))
+	if i < 0 {
+		writeNotFound(w, "API key", r.PathValue("api_key_id"))
+		return
+	}
+
+	writeJSON(w, s.apiKeys[i])
+}
+
+func (s *Server) updateApiKey(w http.ResponseWriter, r *http.Request) {
+	i := s.findApiKey(r.PathValue("api_key_id"))
+	if i < 0 {
+		writeNotFound(w, "API key", r.PathValue("api_key_id"))
+		return
+	}
+
+	var body apiclient.UpdateApiKeyJSONRequestBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	apiKey := s.apiKeys[i]
+	if apiKey.Status == "archived" {
+		writeInvalidRequest(w, "Archived API keys cannot be updated")
+		return
+	}
+	if body.Name != nil && *body.Name == "" {
+		writeInvalidRequest(w, "name: String should have at least 1 character")
+		return
+	}
+	if body.Status != nil && !slices.Contains(apiKeyStatuses, *body.Status) {
+		writeInvalidRequest(w, "status: Input should be 'active', 'inactive' or 'archived'")
+		return
+	}
+
+	if body.Name != nil {
+		apiKey.Name = *body.Name
+	}
+	if body.Status != nil {
+		apiKey.Status = *body.Status
+	}
+
+	writeJSON(w, apiKey)
+}
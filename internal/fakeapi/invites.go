@@ -0,0 +1,77 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+// inviteValidity is how long an invite can be accepted for.
+const inviteValidity = 21 * 24 * time.Hour
+
+func (s *Server) findInvite(id string) int {
+	return slices.IndexFunc(s.invites, func(i *apiclient.Invite) bool { return i.Id == id })
+}
+
+func (s *Server) listInvites(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.invites, func(i *apiclient.Invite) string { return i.Id })
+}
+
+func (s *Server) createInvite(w http.ResponseWriter, r *http.Request) {
+	var body apiclient.CreateInviteJSONRequestBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if !strings.Contains(body.Email, "@") {
+		writeInvalidRequest(w, "email: value is not a valid email address")
+		return
+	}
+	if !slices.Contains(organizationRoles, body.Role) {
+		writeInvalidRequest(w, "role: Input should be 'user', 'developer', 'billing', 'admin' or 'claude_code_user'")
+		return
+	}
+	if slices.ContainsFunc(s.users, func(u *apiclient.User) bool { return strings.EqualFold(u.Email, body.Email) }) {
+		writeInvalidRequest(w, "email: A user with this email address is already a member of the organization")
+		return
+	}
+
+	now := time.Now()
+	invite := &apiclient.Invite{
+		Id:        s.newId("invite"),
+		Email:     body.Email,
+		Role:      body.Role,
+		Status:    "pending",
+		CreatedAt: timestamp(now),
+		ExpiresAt: timestamp(now.Add(inviteValidity)),
+	}
+	s.invites = append(s.invites, invite)
+
+	writeJSON(w, invite)
+}
+
+func (s *Server) getInvite(w http.ResponseWriter, r *http.Request) {
+	i := s.findInvite(r.PathValue("invite_id"))
+	if i < 0 {
+		writeNotFound(w, "Invite", r.PathValue("invite_id"))
+		return
+	}
+
+	writeJSON(w, s.invites[i])
+}
+
+func (s *Server) deleteInvite(w http.ResponseWriter, r *http.Request) {
+	i := s.findInvite(r.PathValue("invite_id"))
+	if i < 0 {
+		writeNotFound(w, "Invite", r.PathValue("invite_id"))
+		return
+	}
+
+	invite := s.invites[i]
+	s.invites = slices.Delete(s.invites, i, i+1)
+
+	writeJSON(w, invite)
+}
@@ -0,0 +1,27 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func (s *Server) listModels(w http.ResponseWriter, r *http.Request) {
+	writeList(w, r, s.models, func(m apiclient.Model) string { return m.Id })
+}
+
+func (s *Server) getModel(w http.ResponseWriter, r *http.Request) {
+	modelId := r.PathValue("model_id")
+	if id, ok := s.modelAliases[modelId]; ok {
+		modelId = id
+	}
+
+	i := slices.IndexFunc(s.models, func(m apiclient.Model) bool { return m.Id == modelId })
+	if i < 0 {
+		writeNotFound(w, "Model", r.PathValue("model_id"))
+		return
+	}
+
+	writeJSON(w, s.models[i])
+}
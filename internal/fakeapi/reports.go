@@ -0,0 +1,200 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+// reportBucketWidth is a bucket width accepted by a report, with the default
+// and maximum number of buckets per page.
+type reportBucketWidth struct {
+	width        time.Duration
+	defaultLimit int
+	maxLimit     int
+}
+
+var (
+	messagesUsageReportBucketWidths = map[string]reportBucketWidth{
+		"1m": {time.Minute, 60, 1440},
+		"1h": {time.Hour, 24, 168},
+		"1d": {24 * time.Hour, 7, 31},
+	}
+	costReportBucketWidths = map[string]reportBucketWidth{
+		"1d": {24 * time.Hour, 7, 31},
+	}
+)
+
+// reportUsage is the usage that the fake API reports for every time bucket,
+// by model.
+var reportUsage = []struct {
+	model               string
+	description         string
+	uncachedInputTokens int64
+	outputTokens        int64
+	costCents           int64
+}{
+	{"claude-sonnet-4-5-20250929", "Claude Sonnet 4.5 Usage - Input Tokens", 1000, 200, 300},
+	{"claude-3-5-haiku-20241022", "Claude Haiku 3.5 Usage - Input Tokens", 4000, 800, 320},
+}
+
+// reportResponse is the body of the report endpoints, which page with an
+// opaque next_page token.
+type reportResponse[T any] struct {
+	Data     []T     `json:"data"`
+	HasMore  bool    `json:"has_more"`
+	NextPage *string `json:"next_page"`
+}
+
+// writeReport writes a page of time buckets, each filled by bucket with the
+// same usage. The page token is the start of the next bucket.
+func writeReport[T any](w http.ResponseWriter, r *http.Request, widths map[string]reportBucketWidth, bucket func(startingAt, endingAt string) T) {
+	query := r.URL.Query()
+
+	startingAt, err := time.Parse(time.RFC3339, query.Get("starting_at"))
+	if err != nil {
+		writeInvalidRequest(w, "starting_at: Input should be a valid RFC 3339 datetime")
+		return
+	}
+
+	var endingAt time.Time
+	if v := query.Get("ending_at"); v != "" {
+		endingAt, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeInvalidRequest(w, "ending_at: Input should be a valid RFC 3339 datetime")
+			return
+		}
+		if !endingAt.After(startingAt) {
+			writeInvalidRequest(w, "ending_at: Must be after starting_at")
+			return
+		}
+	}
+
+	bucketWidth := query.Get("bucket_width")
+	if bucketWidth == "" {
+		bucketWidth = "1d"
+	}
+	width, ok := widths[bucketWidth]
+	if !ok {
+		writeInvalidRequest(w, fmt.Sprintf("bucket_width: Unsupported bucket width %q", bucketWidth))
+		return
+	}
+
+	limit := width.defaultLimit
+	if v := query.Get("limit"); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > width.maxLimit {
+			writeInvalidRequest(w, fmt.Sprintf("limit: Must be an integer between 1 and %d for bucket_width %s", width.maxLimit, bucketWidth))
+			return
+		}
+	}
+
+	start := startingAt
+	if v := query.Get("page"); v != "" {
+		start, err = time.Parse(time.RFC3339, v)
+		if err != nil || start.Before(startingAt) {
+			writeInvalidRequest(w, "page: Invalid page token")
+			return
+		}
+	}
+
+	resp := reportResponse[T]{
+		Data: []T{},
+	}
+	for ; len(resp.Data) < limit; start = start.Add(width.width) {
+		if !endingAt.IsZero() && !start.Before(endingAt) {
+			break
+		}
+		resp.Data = append(resp.Data, bucket(timestamp(start), timestamp(start.Add(width.width))))
+	}
+
+	if !endingAt.IsZero() && start.Before(endingAt) {
+		resp.HasMore = true
+		resp.NextPage = new(timestamp(start))
+	}
+
+	writeJSON(w, resp)
+}
+
+func (s *Server) getMessagesUsageReport(w http.ResponseWriter, r *http.Request) {
+	byModel := slices.Contains(r.URL.Query()["group_by[]"], "model")
+
+	writeReport(w, r, messagesUsageReportBucketWidths, func(startingAt, endingAt string) apiclient.MessagesUsageReportBucket {
+		results := []apiclient.MessagesUsageReportResult{}
+		if byModel {
+			for _, u := range reportUsage {
+				results = append(results, apiclient.MessagesUsageReportResult{
+					UncachedInputTokens: u.uncachedInputTokens,
+					OutputTokens:        u.outputTokens,
+					Model:               new(u.model),
+				})
+			}
+		} else {
+			var result apiclient.MessagesUsageReportResult
+			for _, u := range reportUsage {
+				result.UncachedInputTokens += u.uncachedInputTokens
+				result.OutputTokens += u.outputTokens
+			}
+			results = append(results, result)
+		}
+
+		return apiclient.MessagesUsageReportBucket{
+			StartingAt: startingAt,
+			EndingAt:   endingAt,
+			Results:    results,
+		}
+	})
+}
+
+func (s *Server) getCostReport(w http.ResponseWriter, r *http.Request) {
+	byDescription := slices.Contains(r.URL.Query()["group_by[]"], "description")
+
+	writeReport(w, r, costReportBucketWidths, func(startingAt, endingAt string) apiclient.CostReportBucket {
+		results := []apiclient.CostReportResult{}
+		if byDescription {
+			for _, u := range reportUsage {
+				results = append(results, apiclient.CostReportResult{
+					Amount:      strconv.FormatInt(u.costCents, 10),
+					Currency:    "USD",
+					Description: new(u.description),
+					CostType:    new("tokens"),
+					Model:       new(u.model),
+					TokenType:   new("uncached_input_tokens"),
+				})
+			}
+		} else {
+			var costCents int64
+			for _, u := range reportUsage {
+				costCents += u.costCents
+			}
+			results = append(results, apiclient.CostReportResult{
+				Amount:   strconv.FormatInt(costCents, 10),
+				Currency: "USD",
+			})
+		}
+
+		return apiclient.CostReportBucket{
+			StartingAt: startingAt,
+			EndingAt:   endingAt,
+			Results:    results,
+		}
+	})
+}
+
+func (s *Server) getClaudeCodeUsageReport(w http.ResponseWriter, r *http.Request) {
+	if _, err := time.Parse(time.DateOnly, r.URL.Query().Get("starting_at")); err != nil {
+		writeInvalidRequest(w, "starting_at: Input should be a valid date in YYYY-MM-DD format")
+		return
+	}
+	if _, ok := parseLimit(w, r, defaultLimit); !ok {
+		return
+	}
+
+	writeJSON(w, reportResponse[apiclient.ClaudeCodeUsageRecord]{
+		Data: []apiclient.ClaudeCodeUsageRecord{},
+	})
+}
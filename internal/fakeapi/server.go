@@ -0,0 +1,333 @@
+// Package fakeapi implements the Anthropic API paths used by the provider in
+// memory, so that the acceptance tests can run without real credentials.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+// Credentials and fixtures that every new Server starts with.
+const (
+	AdminApiKey = "sk-ant-admin01-fakeapi"
+	ApiKey      = "sk-ant-api03-fakeapi"
+
+	OrganizationId = "8f3c2a1e-5b7d-4e9f-a6c0-1d2e3f4a5b6c"
+	UserId         = "user_fakeapi00000000000000000000"
+	ApiKeyId       = "apikey_fakeapi00000000000000000000"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 1000
+)
+
+// Server is an in-memory Anthropic API. Use it as the handler of an
+// httptest.Server and point ANTHROPIC_BASE_URL at the server's URL.
+type Server struct {
+	mux *http.ServeMux
+
+	mu           sync.Mutex
+	nextId       int
+	organization apiclient.Organization
+	users        []*apiclient.User
+	invites      []*apiclient.Invite
+	workspaces   []*apiclient.Workspace
+	members      map[string][]*apiclient.WorkspaceMember
+	apiKeys      []*apiclient.ApiKey
+	models       []apiclient.Model
+	modelAliases map[string]string
+}
+
+// New returns a Server seeded with an organization owned by UserId, the API
+// key ApiKeyId and the current models.
+func New() *Server {
+	now := timestamp(time.Now())
+
+	s := &Server{
+		mux: http.NewServeMux(),
+		organization: apiclient.Organization{
+			Id:   OrganizationId,
+			Name: "Fake Organization",
+		},
+		users: []*apiclient.User{
+			{
+				Id:      UserId,
+				Name:    "Fake Owner",
+				Email:   "owner@example.com",
+				Role:    "admin",
+				AddedAt: now,
+			},
+		},
+		members: make(map[string][]*apiclient.WorkspaceMember),
+		apiKeys: []*apiclient.ApiKey{
+			{
+				Id:             ApiKeyId,
+				Name:           "fake-api-key",
+				WorkspaceId:    nil,
+				CreatedAt:      now,
+				PartialKeyHint: new("sk-ant-api03-R2D...igAA"),
+				Status:         "active",
+			},
+		},
+		models: []apiclient.Model{
+			{Id: "claude-sonnet-4-5-20250929", DisplayName: "Claude Sonnet 4.5", CreatedAt: "2025-09-29T00:00:00Z"},
+			{Id: "claude-opus-4-1-20250805", DisplayName: "Claude Opus 4.1", CreatedAt: "2025-08-05T00:00:00Z"},
+			{Id: "claude-opus-4-20250514", DisplayName: "Claude Opus 4", CreatedAt: "2025-05-22T00:00:00Z"},
+			{Id: "claude-sonnet-4-20250514", DisplayName: "Claude Sonnet 4", CreatedAt: "2025-05-22T00:00:00Z"},
+			{Id: "claude-3-5-haiku-20241022", DisplayName: "Claude Haiku 3.5", CreatedAt: "2024-10-22T00:00:00Z"},
+		},
+		modelAliases: map[string]string{
+			"claude-sonnet-4-5": "claude-sonnet-4-5-20250929",
+			"claude-opus-4-1":   "claude-opus-4-1-20250805",
+			"claude-opus-4-0":   "claude-opus-4-20250514",
+			"claude-sonnet-4-0": "claude-sonnet-4-20250514",
+			"claude-3-5-haiku":  "claude-3-5-haiku-20241022",
+		},
+	}
+	s.apiKeys[0].CreatedBy.Id = UserId
+	s.apiKeys[0].CreatedBy.Type = "user"
+
+	s.mux.HandleFunc("GET /v1/organizations/me", s.getOrganization)
+
+	s.mux.HandleFunc("GET /v1/organizations/users", s.listUsers)
+	s.mux.HandleFunc("GET /v1/organizations/users/{user_id}", s.getUser)
+	s.mux.HandleFunc("POST /v1/organizations/users/{user_id}", s.updateUser)
+	s.mux.HandleFunc("DELETE /v1/organizations/users/{user_id}", s.deleteUser)
+
+	s.mux.HandleFunc("GET /v1/organizations/invites", s.listInvites)
+	s.mux.HandleFunc("POST /v1/organizations/invites", s.createInvite)
+	s.mux.HandleFunc("GET /v1/organizations/invites/{invite_id}", s.getInvite)
+	s.mux.HandleFunc("DELETE /v1/organizations/invites/{invite_id}", s.deleteInvite)
+
+	s.mux.HandleFunc("GET /v1/organizations/workspaces", s.listWorkspaces)
+	s.mux.HandleFunc("POST /v1/organizations/workspaces", s.createWorkspace)
+	s.mux.HandleFunc("GET /v1/organizations/workspaces/{workspace_id}", s.getWorkspace)
+	s.mux.HandleFunc("POST /v1/organizations/workspaces/{workspace_id}", s.updateWorkspace)
+	s.mux.HandleFunc("POST /v1/organizations/workspaces/{workspace_id}/archive", s.archiveWorkspace)
+
+	s.mux.HandleFunc("GET /v1/organizations/workspaces/{workspace_id}/members", s.listWorkspaceMembers)
+	s.mux.HandleFunc("POST /v1/organizations/workspaces/{workspace_id}/members", s.createWorkspaceMember)
+	s.mux.HandleFunc("GET /v1/organizations/workspaces/{workspace_id}/members/{user_id}", s.getWorkspaceMember)
+	s.mux.HandleFunc("POST /v1/organizations/workspaces/{workspace_id}/members/{user_id}", s.updateWorkspaceMember)
+	s.mux.HandleFunc("DELETE /v1/organizations/workspaces/{workspace_id}/members/{user_id}", s.deleteWorkspaceMember)
+
+	s.mux.HandleFunc("GET /v1/organizations/api_keys", s.listApiKeys)
+	s.mux.HandleFunc("GET /v1/organizations/api_keys/{api_key_id}", s.getApiKey)
+	s.mux.HandleFunc("POST /v1/organizations/api_keys/{api_key_id}", s.updateApiKey)
+
+	s.mux.HandleFunc("GET /v1/organizations/usage_report/messages", s.getMessagesUsageReport)
+	s.mux.HandleFunc("GET /v1/organizations/cost_report", s.getCostReport)
+	s.mux.HandleFunc("GET /v1/organizations/usage_report/claude_code", s.getClaudeCodeUsageReport)
+
+	s.mux.HandleFunc("GET /v1/models", s.listModels)
+	s.mux.HandleFunc("GET /v1/models/{model_id}", s.getModel)
+
+	return s
+}
+
+// AddUser adds a member to the organization and returns its ID.
+func (s *Server) AddUser(name, email, role string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user := &apiclient.User{
+		Id:      s.newId("user"),
+		Name:    name,
+		Email:   email,
+		Role:    role,
+		AddedAt: timestamp(time.Now()),
+	}
+	s.users = append(s.users, user)
+
+	return user.Id
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.nextId++
+	w.Header().Set("request-id", fmt.Sprintf("req_fakeapi%016d", s.nextId))
+	s.mu.Unlock()
+
+	if r.Header.Get("anthropic-version") == "" {
+		writeError(w, http.StatusBadRequest, apiclient.ErrorTypeInvalidRequest, "anthropic-version: header is required")
+		return
+	}
+
+	// Admin API keys only work with the Admin API, and standard API keys only
+	// work with the rest of the API.
+	apiKey := r.Header.Get("x-api-key")
+	isAdminPath := strings.HasPrefix(r.URL.Path, "/v1/organizations/")
+	switch {
+	case apiKey == "":
+		writeError(w, http.StatusUnauthorized, apiclient.ErrorTypeAuthentication, "x-api-key header is required")
+		return
+	case apiKey != AdminApiKey && apiKey != ApiKey:
+		writeError(w, http.StatusUnauthorized, apiclient.ErrorTypeAuthentication, "invalid x-api-key")
+		return
+	case isAdminPath && apiKey != AdminApiKey:
+		writeError(w, http.StatusForbidden, apiclient.ErrorTypePermission, "This endpoint requires an Admin API key.")
+		return
+	case !isAdminPath && apiKey != ApiKey:
+		writeError(w, http.StatusUnauthorized, apiclient.ErrorTypeAuthentication, "invalid x-api-key")
+		return
+	}
+
+	// Unknown paths and methods get the API's error body rather than the
+	// plain text responses of http.ServeMux.
+	if _, pattern := s.mux.Handler(r); pattern == "" {
+		writeError(w, http.StatusNotFound, apiclient.ErrorTypeNotFound, "Not found")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// newId returns a new object ID. IDs increase so that they sort in creation
+// order, like the IDs the API returns.
+func (s *Server) newId(prefix string) string {
+	s.nextId++
+	return fmt.Sprintf("%s_fakeapi%020d", prefix, s.nextId)
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+// errorResponse is the body of unsuccessful responses.
+type errorResponse struct {
+	Type  string `json:"type"`
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, statusCode int, errorType, message string) {
+	body := errorResponse{
+		Type: "error",
+	}
+	body.Error.Type = errorType
+	body.Error.Message = message
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeNotFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, apiclient.ErrorTypeNotFound, fmt.Sprintf("%s %s not found", kind, id))
+}
+
+func writeInvalidRequest(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusBadRequest, apiclient.ErrorTypeInvalidRequest, message)
+}
+
+// decodeBody decodes the JSON request body into v, writing an error response
+// if it is invalid.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeInvalidRequest(w, fmt.Sprintf("Invalid request body: %s", err))
+		return false
+	}
+	return true
+}
+
+// parseLimit returns the limit query parameter, writing an error response if
+// it is invalid.
+func parseLimit(w http.ResponseWriter, r *http.Request, defaultValue int) (int, bool) {
+	v := r.URL.Query().Get("limit")
+	if v == "" {
+		return defaultValue, true
+	}
+
+	limit, err := strconv.Atoi(v)
+	if err != nil || limit < 1 || limit > maxLimit {
+		writeInvalidRequest(w, fmt.Sprintf("limit: Must be an integer between 1 and %d", maxLimit))
+		return 0, false
+	}
+	return limit, true
+}
+
+// listResponse is the body of the list endpoints that page with before_id
+// and after_id.
+type listResponse[T any] struct {
+	Data    []T     `json:"data"`
+	HasMore bool    `json:"has_more"`
+	FirstId *string `json:"first_id"`
+	LastId  *string `json:"last_id"`
+}
+
+// writeList writes a page of items, which are in the order the API lists
+// them, honoring the limit, before_id and after_id query parameters.
+func writeList[T any](w http.ResponseWriter, r *http.Request, items []T, id func(T) string) {
+	limit, ok := parseLimit(w, r, defaultLimit)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	beforeId, afterId := query.Get("before_id"), query.Get("after_id")
+	if beforeId != "" && afterId != "" {
+		writeInvalidRequest(w, "Only one of before_id and after_id may be specified")
+		return
+	}
+
+	cursorIndex := func(cursor string) (int, bool) {
+		i := slices.IndexFunc(items, func(item T) bool { return id(item) == cursor })
+		if i < 0 {
+			writeInvalidRequest(w, fmt.Sprintf("Invalid cursor %q", cursor))
+			return 0, false
+		}
+		return i, true
+	}
+
+	var page []T
+	var hasMore bool
+	switch {
+	case beforeId != "":
+		end, ok := cursorIndex(beforeId)
+		if !ok {
+			return
+		}
+		start := max(end-limit, 0)
+		page, hasMore = items[start:end], start > 0
+	default:
+		start := 0
+		if afterId != "" {
+			i, ok := cursorIndex(afterId)
+			if !ok {
+				return
+			}
+			start = i + 1
+		}
+		end := min(start+limit, len(items))
+		page, hasMore = items[start:end], end < len(items)
+	}
+
+	resp := listResponse[T]{
+		Data:    append([]T{}, page...),
+		HasMore: hasMore,
+	}
+	if len(page) > 0 {
+		resp.FirstId = new(id(page[0]))
+		resp.LastId = new(id(page[len(page)-1]))
+	}
+	writeJSON(w, resp)
+}
@@ -0,0 +1,221 @@
+package fakeapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func newTestClient(t *testing.T, apiKey string) *apiclient.ClientWithResponses {
+	t.Helper()
+
+	srv := httptest.NewServer(New())
+	t.Cleanup(srv.Close)
+
+	client, err := apiclient.NewClientWithResponses(
+		srv.URL,
+		apiclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("anthropic-version", apiclient.DefaultApiVersion)
+			req.Header.Set("x-api-key", apiKey)
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return client
+}
+
+func TestServer_authentication(t *testing.T) {
+	testCases := []struct {
+		name     string
+		apiKey   string
+		wantType string
+	}{
+		{"missing", "", apiclient.ErrorTypeAuthentication},
+		{"invalid", "sk-ant-admin01-invalid", apiclient.ErrorTypeAuthentication},
+		{"standard", ApiKey, apiclient.ErrorTypePermission},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			httpResp, err := newTestClient(t, tc.apiKey).GetOrganizationWithResponse(context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			apiErr := apiclient.NewAPIError(httpResp.HTTPResponse, httpResp.Body)
+			if apiErr.Type != tc.wantType || apiErr.RequestId == "" {
+				t.Errorf("got %+v, want error type %s with a request ID", apiErr, tc.wantType)
+			}
+		})
+	}
+}
+
+func TestServer_pagination(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, AdminApiKey)
+
+	var want []string
+	for i := range 5 {
+		httpResp, err := client.CreateWorkspaceWithResponse(ctx, apiclient.CreateWorkspaceJSONRequestBody{
+			Name: fmt.Sprintf("tf-workspace-%d", i),
+		})
+		if err != nil || httpResp.JSON200 == nil {
+			t.Fatalf("unable to create workspace: %v %s", err, httpResp.Body)
+		}
+		want = append(want, httpResp.JSON200.Id)
+	}
+
+	var got []string
+	params := &apiclient.ListWorkspacesParams{
+		Limit: new(2),
+	}
+	for {
+		httpResp, err := client.ListWorkspacesWithResponse(ctx, params)
+		if err != nil || httpResp.JSON200 == nil {
+			t.Fatalf("unable to list workspaces: %v %s", err, httpResp.Body)
+		}
+
+		for _, workspace := range httpResp.JSON200.Data {
+			got = append(got, workspace.Id)
+		}
+
+		if !httpResp.JSON200.HasMore {
+			break
+		}
+		params.AfterId = httpResp.JSON200.LastId
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	httpResp, err := client.ListWorkspacesWithResponse(ctx, &apiclient.ListWorkspacesParams{
+		BeforeId: new(want[3]),
+		Limit:    new(2),
+	})
+	if err != nil || httpResp.JSON200 == nil {
+		t.Fatalf("unable to list workspaces: %v %s", err, httpResp.Body)
+	}
+	if *httpResp.JSON200.FirstId != want[1] || *httpResp.JSON200.LastId != want[2] || !httpResp.JSON200.HasMore {
+		t.Errorf("unexpected page before %s: %+v", want[3], httpResp.JSON200)
+	}
+}
+
+func TestServer_archiveWorkspace(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, AdminApiKey)
+
+	createResp, err := client.CreateWorkspaceWithResponse(ctx, apiclient.CreateWorkspaceJSONRequestBody{Name: "tf-workspace"})
+	if err != nil || createResp.JSON200 == nil {
+		t.Fatalf("unable to create workspace: %v %s", err, createResp.Body)
+	}
+	workspaceId := createResp.JSON200.Id
+
+	memberResp, err := client.CreateWorkspaceMemberWithResponse(ctx, workspaceId, apiclient.CreateWorkspaceMemberJSONRequestBody{
+		UserId:        UserId,
+		WorkspaceRole: "workspace_user",
+	})
+	if err != nil || memberResp.StatusCode() != http.StatusOK {
+		t.Fatalf("unable to create workspace member: %v %s", err, memberResp.Body)
+	}
+
+	archiveResp, err := client.ArchiveWorkspaceWithResponse(ctx, workspaceId)
+	if err != nil || archiveResp.JSON200 == nil || archiveResp.JSON200.ArchivedAt == nil {
+		t.Fatalf("unable to archive workspace: %v %s", err, archiveResp.Body)
+	}
+
+	listResp, err := client.ListWorkspacesWithResponse(ctx, &apiclient.ListWorkspacesParams{})
+	if err != nil || listResp.JSON200 == nil {
+		t.Fatalf("unable to list workspaces: %v %s", err, listResp.Body)
+	}
+	if len(listResp.JSON200.Data) != 0 {
+		t.Errorf("archived workspace was listed: %+v", listResp.JSON200.Data)
+	}
+
+	listResp, err = client.ListWorkspacesWithResponse(ctx, &apiclient.ListWorkspacesParams{IncludeArchived: new(true)})
+	if err != nil || listResp.JSON200 == nil {
+		t.Fatalf("unable to list workspaces: %v %s", err, listResp.Body)
+	}
+	if len(listResp.JSON200.Data) != 1 {
+		t.Errorf("archived workspace was not listed with include_archived: %+v", listResp.JSON200.Data)
+	}
+
+	getMemberResp, err := client.GetWorkspaceMemberWithResponse(ctx, workspaceId, UserId)
+	if err != nil || getMemberResp.StatusCode() != http.StatusNotFound {
+		t.Errorf("got status code %d for a member of an archived workspace, want %d", getMemberResp.StatusCode(), http.StatusNotFound)
+	}
+
+	updateResp, err := client.UpdateWorkspaceWithResponse(ctx, workspaceId, apiclient.UpdateWorkspaceJSONRequestBody{Name: "tf-workspace-updated"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if apiErr := apiclient.NewAPIError(updateResp.HTTPResponse, updateResp.Body); apiErr.Type != apiclient.ErrorTypeInvalidRequest {
+		t.Errorf("got %+v, want an invalid request error", apiErr)
+	}
+}
+
+func TestServer_notFound(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t, AdminApiKey)
+
+	httpResp, err := client.GetInviteWithResponse(ctx, "invite_does_not_exist")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	apiErr := apiclient.NewAPIError(httpResp.HTTPResponse, httpResp.Body)
+	if apiErr.StatusCode != http.StatusNotFound || apiErr.Type != apiclient.ErrorTypeNotFound || apiErr.Message == "" {
+		t.Errorf("got %+v, want a not found error", apiErr)
+	}
+}
+
+func TestServer_usageReport(t *testing.T) {
+	client := newTestClient(t, AdminApiKey)
+
+	var buckets []apiclient.MessagesUsageReportBucket
+	params := &apiclient.GetMessagesUsageReportParams{
+		StartingAt:  "2025-01-01T00:00:00Z",
+		EndingAt:    new("2025-01-02T00:00:00Z"),
+		BucketWidth: new("1h"),
+		Limit:       new(10),
+	}
+	for {
+		httpResp, err := client.GetMessagesUsageReportWithResponse(context.Background(), params)
+		if err != nil || httpResp.JSON200 == nil {
+			t.Fatalf("unable to read usage report: %v %s", err, httpResp.Body)
+		}
+
+		buckets = append(buckets, httpResp.JSON200.Data...)
+
+		if !httpResp.JSON200.HasMore {
+			break
+		}
+		params.Page = httpResp.JSON200.NextPage
+	}
+
+	if len(buckets) != 24 || buckets[23].EndingAt != "2025-01-02T00:00:00Z" {
+		t.Errorf("got %d buckets, want 24 ending at 2025-01-02T00:00:00Z", len(buckets))
+	}
+	for _, bucket := range buckets {
+		if len(bucket.Results) != 1 || bucket.Results[0].UncachedInputTokens != 5000 || bucket.Results[0].Model != nil {
+			t.Fatalf("got results %+v, want the usage of all models", bucket.Results)
+		}
+	}
+}
+
+func TestServer_getModel(t *testing.T) {
+	httpResp, err := newTestClient(t, ApiKey).GetModelWithResponse(context.Background(), "claude-sonnet-4-5")
+	if err != nil || httpResp.JSON200 == nil {
+		t.Fatalf("unable to get model: %v %s", err, httpResp.Body)
+	}
+
+	if httpResp.JSON200.Id != "claude-sonnet-4-5-20250929" {
+		t.Errorf("got model %s, want the model the alias points to", httpResp.JSON200.Id)
+	}
+}
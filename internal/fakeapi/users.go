@@ -0,0 +1,87 @@
+package fakeapi
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+var organizationRoles = []string{"user", "developer", "billing", "admin", "claude_code_user"}
+
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.organization)
+}
+
+func (s *Server) findUser(id string) int {
+	return slices.IndexFunc(s.users, func(u *apiclient.User) bool { return u.Id == id })
+}
+
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	users := s.users
+	if email := r.URL.Query().Get("email"); email != "" {
+		users = nil
+		for _, u := range s.users {
+			if strings.EqualFold(u.Email, email) {
+				users = append(users, u)
+			}
+		}
+	}
+
+	writeList(w, r, users, func(u *apiclient.User) string { return u.Id })
+}
+
+func (s *Server) getUser(w http.ResponseWriter, r *http.Request) {
+	i := s.findUser(r.PathValue("user_id"))
+	if i < 0 {
+		writeNotFound(w, "User", r.PathValue("user_id"))
+		return
+	}
+
+	writeJSON(w, s.users[i])
+}
+
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	i := s.findUser(r.PathValue("user_id"))
+	if i < 0 {
+		writeNotFound(w, "User", r.PathValue("user_id"))
+		return
+	}
+
+	var body apiclient.UpdateUserJSONRequestBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if !slices.Contains(organizationRoles, body.Role) {
+		writeInvalidRequest(w, "role: Input should be 'user', 'developer', 'billing', 'admin' or 'claude_code_user'")
+		return
+	}
+
+	s.users[i].Role = body.Role
+	writeJSON(w, s.users[i])
+}
+
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	userId := r.PathValue("user_id")
+
+	i := s.findUser(userId)
+	if i < 0 {
+		writeNotFound(w, "User", userId)
+		return
+	}
+
+	s.users = slices.Delete(s.users, i, i+1)
+
+	// Removing a user from the organization also removes them from every
+	// workspace.
+	for workspaceId, members := range s.members {
+		s.members[workspaceId] = slices.DeleteFunc(members, func(m *apiclient.WorkspaceMember) bool { return m.UserId == userId })
+	}
+
+	writeJSON(w, apiclient.UserDeleted{
+		Id:   userId,
+		Type: "user_deleted",
+	})
+}
@@ -0,0 +1,118 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func (s *Server) findWorkspaceMember(workspaceId, userId string) int {
+	return slices.IndexFunc(s.members[workspaceId], func(m *apiclient.WorkspaceMember) bool { return m.UserId == userId })
+}
+
+// workspaceMember returns the index of a member of an existing workspace,
+// writing an error response if either does not exist.
+func (s *Server) workspaceMember(w http.ResponseWriter, r *http.Request) (int, bool) {
+	workspaceId, userId := r.PathValue("workspace_id"), r.PathValue("user_id")
+
+	if s.findWorkspace(workspaceId) < 0 {
+		writeNotFound(w, "Workspace", workspaceId)
+		return 0, false
+	}
+
+	i := s.findWorkspaceMember(workspaceId, userId)
+	if i < 0 {
+		writeNotFound(w, "Workspace member", userId)
+		return 0, false
+	}
+	return i, true
+}
+
+func (s *Server) listWorkspaceMembers(w http.ResponseWriter, r *http.Request) {
+	workspaceId := r.PathValue("workspace_id")
+	if s.findWorkspace(workspaceId) < 0 {
+		writeNotFound(w, "Workspace", workspaceId)
+		return
+	}
+
+	writeList(w, r, s.members[workspaceId], func(m *apiclient.WorkspaceMember) string { return m.UserId })
+}
+
+func (s *Server) createWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	workspace, ok := s.activeWorkspace(w, r.PathValue("workspace_id"))
+	if !ok {
+		return
+	}
+
+	var body apiclient.CreateWorkspaceMemberJSONRequestBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if s.findUser(body.UserId) < 0 {
+		writeInvalidRequest(w, fmt.Sprintf("user_id: User %s is not a member of the organization", body.UserId))
+		return
+	}
+	if !slices.Contains(workspaceRoles, body.WorkspaceRole) {
+		writeInvalidRequest(w, "workspace_role: Input should be 'workspace_user', 'workspace_developer', 'workspace_admin' or 'workspace_billing'")
+		return
+	}
+	if s.findWorkspaceMember(workspace.Id, body.UserId) >= 0 {
+		writeInvalidRequest(w, fmt.Sprintf("user_id: User %s is already a member of workspace %s", body.UserId, workspace.Id))
+		return
+	}
+
+	member := &apiclient.WorkspaceMember{
+		WorkspaceId:   workspace.Id,
+		UserId:        body.UserId,
+		WorkspaceRole: body.WorkspaceRole,
+	}
+	s.members[workspace.Id] = append(s.members[workspace.Id], member)
+
+	writeJSON(w, member)
+}
+
+func (s *Server) getWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	i, ok := s.workspaceMember(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, s.members[r.PathValue("workspace_id")][i])
+}
+
+func (s *Server) updateWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	i, ok := s.workspaceMember(w, r)
+	if !ok {
+		return
+	}
+
+	var body apiclient.UpdateWorkspaceMemberJSONRequestBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if !slices.Contains(workspaceRoles, body.WorkspaceRole) {
+		writeInvalidRequest(w, "workspace_role: Input should be 'workspace_user', 'workspace_developer', 'workspace_admin' or 'workspace_billing'")
+		return
+	}
+
+	member := s.members[r.PathValue("workspace_id")][i]
+	member.WorkspaceRole = body.WorkspaceRole
+	writeJSON(w, member)
+}
+
+func (s *Server) deleteWorkspaceMember(w http.ResponseWriter, r *http.Request) {
+	i, ok := s.workspaceMember(w, r)
+	if !ok {
+		return
+	}
+
+	workspaceId := r.PathValue("workspace_id")
+	member := s.members[workspaceId][i]
+	s.members[workspaceId] = slices.Delete(s.members[workspaceId], i, i+1)
+
+	writeJSON(w, member)
+}
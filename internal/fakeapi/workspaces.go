@@ -0,0 +1,129 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+const maxWorkspaceNameLength = 40
+
+var (
+	workspaceColors = []string{"#6C5BB9", "#C45A9F", "#D27A4B", "#4F8FC3", "#5BA67A", "#B8A53E"}
+	workspaceRoles  = []string{"workspace_user", "workspace_developer", "workspace_admin", "workspace_billing"}
+)
+
+func (s *Server) findWorkspace(id string) int {
+	return slices.IndexFunc(s.workspaces, func(w *apiclient.Workspace) bool { return w.Id == id })
+}
+
+// activeWorkspace returns the workspace with the given ID, writing an error
+// response if it does not exist or has been archived.
+func (s *Server) activeWorkspace(w http.ResponseWriter, id string) (*apiclient.Workspace, bool) {
+	i := s.findWorkspace(id)
+	if i < 0 {
+		writeNotFound(w, "Workspace", id)
+		return nil, false
+	}
+
+	workspace := s.workspaces[i]
+	if workspace.ArchivedAt != nil {
+		writeInvalidRequest(w, fmt.Sprintf("Workspace %s is archived", id))
+		return nil, false
+	}
+	return workspace, true
+}
+
+func validateWorkspaceName(w http.ResponseWriter, name string) bool {
+	switch n := utf8.RuneCountInString(name); {
+	case n == 0:
+		writeInvalidRequest(w, "name: String should have at least 1 character")
+		return false
+	case n > maxWorkspaceNameLength:
+		writeInvalidRequest(w, fmt.Sprintf("name: String should have at most %d characters", maxWorkspaceNameLength))
+		return false
+	}
+	return true
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request) {
+	workspaces := s.workspaces
+	if r.URL.Query().Get("include_archived") != "true" {
+		workspaces = slices.DeleteFunc(slices.Clone(workspaces), func(w *apiclient.Workspace) bool { return w.ArchivedAt != nil })
+	}
+
+	writeList(w, r, workspaces, func(w *apiclient.Workspace) string { return w.Id })
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request) {
+	var body apiclient.CreateWorkspaceJSONRequestBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if !validateWorkspaceName(w, body.Name) {
+		return
+	}
+
+	workspace := &apiclient.Workspace{
+		Id:           s.newId("wrkspc"),
+		Name:         body.Name,
+		CreatedAt:    timestamp(time.Now()),
+		DisplayColor: workspaceColors[len(s.workspaces)%len(workspaceColors)],
+	}
+	s.workspaces = append(s.workspaces, workspace)
+
+	writeJSON(w, workspace)
+}
+
+func (s *Server) getWorkspace(w http.ResponseWriter, r *http.Request) {
+	i := s.findWorkspace(r.PathValue("workspace_id"))
+	if i < 0 {
+		writeNotFound(w, "Workspace", r.PathValue("workspace_id"))
+		return
+	}
+
+	writeJSON(w, s.workspaces[i])
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request) {
+	workspace, ok := s.activeWorkspace(w, r.PathValue("workspace_id"))
+	if !ok {
+		return
+	}
+
+	var body apiclient.UpdateWorkspaceJSONRequestBody
+	if !decodeBody(w, r, &body) {
+		return
+	}
+
+	if !validateWorkspaceName(w, body.Name) {
+		return
+	}
+
+	workspace.Name = body.Name
+	writeJSON(w, workspace)
+}
+
+func (s *Server) archiveWorkspace(w http.ResponseWriter, r *http.Request) {
+	workspace, ok := s.activeWorkspace(w, r.PathValue("workspace_id"))
+	if !ok {
+		return
+	}
+
+	workspace.ArchivedAt = new(timestamp(time.Now()))
+
+	// Archiving a workspace removes its members and archives its API keys.
+	delete(s.members, workspace.Id)
+	for _, apiKey := range s.apiKeys {
+		if apiKey.WorkspaceId != nil && *apiKey.WorkspaceId == workspace.Id {
+			apiKey.Status = "archived"
+		}
+	}
+
+	writeJSON(w, workspace)
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// auditTargetIdNames maps the collections in API paths to the name of the ID
+// that follows them, e.g. /v1/organizations/workspaces/{workspace_id}.
+var auditTargetIdNames = map[string]string{
+	"api_keys":   "api_key_id",
+	"invites":    "invite_id",
+	"members":    "user_id",
+	"users":      "user_id",
+	"workspaces": "workspace_id",
+}
+
+type auditOperationKey struct{}
+
+type auditOperation struct {
+	resourceType string
+	operation    string
+}
+
+// withAuditOperation records the Terraform resource type and operation that
+// the API requests sent with ctx are made for.
+func withAuditOperation(ctx context.Context, resourceType, operation string) context.Context {
+	return context.WithValue(ctx, auditOperationKey{}, auditOperation{
+		resourceType: resourceType,
+		operation:    operation,
+	})
+}
+
+// auditRecord is a line of the audit log.
+type auditRecord struct {
+	Timestamp    string            `json:"timestamp"`
+	ResourceType string            `json:"resource_type,omitempty"`
+	Operation    string            `json:"operation,omitempty"`
+	Method       string            `json:"method"`
+	Path         string            `json:"path"`
+	TargetIds    map[string]string `json:"target_ids,omitempty"`
+	RequestBody  json.RawMessage   `json:"request_body,omitempty"`
+	StatusCode   int               `json:"status_code,omitempty"`
+	RequestId    string            `json:"request_id,omitempty"`
+	Error        string            `json:"error,omitempty"`
+}
+
+// auditLog appends a JSON line to a file for every mutating API request.
+type auditLog struct {
+	path      string
+	redactPii bool
+
+	// mu serializes writes from concurrent resource operations.
+	mu sync.Mutex
+}
+
+// newAuditLog returns an audit log writing to path, creating the file if it
+// does not exist.
+func newAuditLog(path string, redactPii bool) (*auditLog, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	return &auditLog{
+		path:      path,
+		redactPii: redactPii,
+	}, nil
+}
+
+func (l *auditLog) Write(record auditRecord) error {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// The file is opened for every record so that each line is appended with a
+	// single write, even when several provider processes share the file.
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// Transport returns a transport that sends requests through next and records
+// every POST and DELETE request in the audit log.
+func (l *auditLog) Transport(next http.RoundTripper) http.RoundTripper {
+	return &auditTransport{
+		log:  l,
+		next: next,
+	}
+}
+
+type auditTransport struct {
+	log  *auditLog
+	next http.RoundTripper
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost && req.Method != http.MethodDelete {
+		return t.next.RoundTrip(req)
+	}
+
+	record := auditRecord{
+		Method:    req.Method,
+		Path:      req.URL.Path,
+		TargetIds: auditTargetIds(req.URL.Path),
+	}
+	if op, ok := req.Context().Value(auditOperationKey{}).(auditOperation); ok {
+		record.ResourceType = op.resourceType
+		record.Operation = op.operation
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		clone := req.Clone(req.Context())
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+		req = clone
+
+		if t.log.redactPii {
+			body = logEmailRegexp.ReplaceAll(body, []byte("***"))
+		}
+		if json.Valid(body) {
+			record.RequestBody = body
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+
+	record.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.StatusCode = resp.StatusCode
+		record.RequestId = resp.Header.Get("request-id")
+
+		// A create request does not carry the ID of the object it creates,
+		// so take it from the response instead.
+		if name, ok := auditCreatedIdName(req.URL.Path); ok && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			body, rerr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			if rerr != nil {
+				record.Error = rerr.Error()
+				resp, err = nil, rerr
+			} else if id := auditCreatedId(body, name); id != "" {
+				if record.TargetIds == nil {
+					record.TargetIds = make(map[string]string)
+				}
+				record.TargetIds[name] = id
+			}
+		}
+	}
+
+	// A failure to write the audit log does not fail the request, since the
+	// change has already been made and its result must reach the state.
+	if werr := t.log.Write(record); werr != nil {
+		tflog.Error(req.Context(), "Unable to write audit log", map[string]any{
+			"error": werr.Error(),
+		})
+	}
+
+	return resp, err
+}
+
+// auditCreatedIdName returns the name of the ID of the object created by a
+// request to path, if the path is that of a collection, e.g.
+// /v1/organizations/workspaces/{workspace_id}/members.
+func auditCreatedIdName(path string) (string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	name, ok := auditTargetIdNames[segments[len(segments)-1]]
+	return name, ok
+}
+
+// auditCreatedId returns the ID of the object in a response body, preferring
+// a field with the name of the ID, such as the user_id of a workspace member,
+// over its id field.
+func auditCreatedId(body []byte, name string) string {
+	var object map[string]any
+	if err := json.Unmarshal(body, &object); err != nil {
+		return ""
+	}
+
+	for _, key := range []string{name, "id"} {
+		if id, ok := object[key].(string); ok && id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// auditTargetIds extracts the IDs of the objects a request acts on from its
+// path.
+func auditTargetIds(path string) map[string]string {
+	ids := make(map[string]string)
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if name, ok := auditTargetIdNames[segments[i]]; ok {
+			ids[name] = segments[i+1]
+			i++
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+	return ids
+}
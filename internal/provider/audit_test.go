@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestAuditLog(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("request-id", "req_test")
+		switch r.URL.Path {
+		case "/v1/organizations/invites":
+			w.Write([]byte(`{"id":"invite_test","email":"jane@example.com","role":"user"}`))
+		case "/v1/organizations/workspaces/wrk_test/members":
+			w.Write([]byte(`{"type":"workspace_member","user_id":"user_test","workspace_id":"wrk_test","workspace_role":"workspace_user"}`))
+		}
+	}))
+	defer srv.Close()
+
+	auditLogPath := filepath.Join(t.TempDir(), "audit.jsonl")
+	auditLog, err := newAuditLog(auditLogPath, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &http.Client{Transport: auditLog.Transport(http.DefaultTransport)}
+	ctx := withAuditOperation(context.Background(), "anthropic_organization_invite", "create")
+
+	const n = 20
+	var wg sync.WaitGroup
+	for range n {
+		wg.Go(func() {
+			req, _ := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/v1/organizations/invites", strings.NewReader(`{"email":"jane@example.com","role":"user"}`))
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			defer resp.Body.Close()
+
+			// The response body must still reach the client.
+			body, err := io.ReadAll(resp.Body)
+			if err != nil || !strings.Contains(string(body), "invite_test") {
+				t.Errorf("got response body %q, error %v", body, err)
+			}
+		})
+	}
+	wg.Wait()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/v1/organizations/workspaces/wrk_test/members", strings.NewReader(`{"user_id":"user_test","workspace_role":"workspace_user"}`))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	req, _ = http.NewRequestWithContext(ctx, http.MethodDelete, srv.URL+"/v1/organizations/workspaces/wrk_test/members/user_test", nil)
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	resp, err = client.Get(srv.URL + "/v1/organizations/workspaces")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	f, err := os.Open(auditLogPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer f.Close()
+
+	var records []auditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record auditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid audit log line %q: %s", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+
+	if len(records) != n+2 {
+		t.Fatalf("got %d records, want %d", len(records), n+2)
+	}
+
+	invite := records[0]
+	if invite.ResourceType != "anthropic_organization_invite" || invite.Operation != "create" || invite.StatusCode != http.StatusOK || invite.RequestId != "req_test" {
+		t.Errorf("unexpected record: %+v", invite)
+	}
+	if string(invite.RequestBody) != `{"email":"***","role":"user"}` {
+		t.Errorf("got request body %s", invite.RequestBody)
+	}
+	if len(invite.TargetIds) != 1 || invite.TargetIds["invite_id"] != "invite_test" {
+		t.Errorf("got target IDs %v", invite.TargetIds)
+	}
+
+	createdMember := records[n]
+	if createdMember.Method != http.MethodPost || createdMember.TargetIds["workspace_id"] != "wrk_test" || createdMember.TargetIds["user_id"] != "user_test" {
+		t.Errorf("unexpected record: %+v", createdMember)
+	}
+
+	member := records[n+1]
+	if member.Method != http.MethodDelete || member.TargetIds["workspace_id"] != "wrk_test" || member.TargetIds["user_id"] != "user_test" {
+		t.Errorf("unexpected record: %+v", member)
+	}
+}
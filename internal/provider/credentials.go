@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adminApiKeyPrefix is the prefix of Admin API keys.
+const adminApiKeyPrefix = "sk-ant-admin"
+
+// Details of the diagnostics reported when a resource or data source needs an
+// API key that is not configured.
+const (
+	adminApiKeyRequiredDetail     = "Set the api_key, api_key_file or credential_command provider attribute, or the ANTHROPIC_ADMIN_API_KEY environment variable."
+	inferenceApiKeyRequiredDetail = "Set the inference_api_key provider attribute, or the ANTHROPIC_API_KEY environment variable to a standard API key."
+)
+
+// credentialCommandTimeout is the maximum time a credential command may run.
+const credentialCommandTimeout = time.Minute
+
+// resolveApiKey returns the Admin API key from the first configured source, in
+// order of precedence: the api_key, api_key_file and credential_command
+// attributes, then the ANTHROPIC_ADMIN_API_KEY environment variable, then the
+// ANTHROPIC_API_KEY environment variable if it holds an Admin API key. It
+// returns an empty string if no key is configured.
+func resolveApiKey(ctx context.Context, data AnthropicProviderModel) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var apiKey string
+	var apiKeyAttr path.Path
+	switch {
+	case !data.ApiKey.IsNull():
+		apiKey = data.ApiKey.ValueString()
+		apiKeyAttr = path.Root("api_key")
+	case !data.ApiKeyFile.IsNull():
+		apiKeyAttr = path.Root("api_key_file")
+
+		b, err := os.ReadFile(data.ApiKeyFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(apiKeyAttr, "Invalid API Key File", fmt.Sprintf("Unable to read API key file: %s", err))
+			return "", diags
+		}
+		apiKey = strings.TrimSpace(string(b))
+	case len(data.CredentialCommand) > 0:
+		apiKeyAttr = path.Root("credential_command")
+
+		v, err := runCredentialCommand(ctx, data.CredentialCommand)
+		if err != nil {
+			diags.AddAttributeError(apiKeyAttr, "Credential Command Failed", fmt.Sprintf("Unable to get API key from credential command: %s", err))
+			return "", diags
+		}
+		apiKey = v
+	default:
+		apiKey = os.Getenv("ANTHROPIC_ADMIN_API_KEY")
+		if v := os.Getenv("ANTHROPIC_API_KEY"); apiKey == "" && strings.HasPrefix(v, adminApiKeyPrefix) {
+			apiKey = v
+		}
+		apiKeyAttr = path.Root("api_key")
+	}
+
+	if apiKey != "" && !strings.HasPrefix(apiKey, adminApiKeyPrefix) {
+		diags.AddAttributeWarning(
+			apiKeyAttr,
+			"Unexpected API Key Type",
+			fmt.Sprintf("The API key does not start with %q and may not be an Admin API key. Most resources and data sources require an Admin API key, which can be created by an organization admin in the Anthropic Console.", adminApiKeyPrefix),
+		)
+	}
+
+	return apiKey, diags
+}
+
+// resolveInferenceApiKey returns the API key for the standard API from the
+// inference_api_key attribute, or from the ANTHROPIC_API_KEY environment
+// variable unless that holds an Admin API key. It returns an empty string if no
+// key is configured.
+func resolveInferenceApiKey(data AnthropicProviderModel) string {
+	if !data.InferenceApiKey.IsNull() {
+		return data.InferenceApiKey.ValueString()
+	}
+
+	if v := os.Getenv("ANTHROPIC_API_KEY"); !strings.HasPrefix(v, adminApiKeyPrefix) {
+		return v
+	}
+
+	return ""
+}
+
+// runCredentialCommand runs the credential command and returns the API key
+// printed to its standard output.
+func runCredentialCommand(ctx context.Context, command []types.String) (string, error) {
+	args := make([]string, len(command))
+	for i, v := range command {
+		args[i] = v.ValueString()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, credentialCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("unable to run %q: %w: %s", args[0], err, msg)
+		}
+		return "", fmt.Errorf("unable to run %q: %w", args[0], err)
+	}
+
+	apiKey := strings.TrimSpace(stdout.String())
+	if apiKey == "" {
+		return "", fmt.Errorf("%q did not print an API key to standard output", args[0])
+	}
+
+	return apiKey, nil
+}
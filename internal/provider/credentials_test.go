@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveApiKey(t *testing.T) {
+	apiKeyFile := filepath.Join(t.TempDir(), "api_key")
+	if err := os.WriteFile(apiKeyFile, []byte("sk-ant-admin01-file\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		name        string
+		data        AnthropicProviderModel
+		env         map[string]string
+		want        string
+		wantWarning bool
+		wantErr     bool
+	}{
+		{
+			name: "api_key",
+			data: AnthropicProviderModel{ApiKey: types.StringValue("sk-ant-admin01-attr")},
+			env:  map[string]string{"ANTHROPIC_ADMIN_API_KEY": "sk-ant-admin01-env"},
+			want: "sk-ant-admin01-attr",
+		},
+		{
+			name: "api_key_file",
+			data: AnthropicProviderModel{ApiKeyFile: types.StringValue(apiKeyFile)},
+			env:  map[string]string{"ANTHROPIC_ADMIN_API_KEY": "sk-ant-admin01-env"},
+			want: "sk-ant-admin01-file",
+		},
+		{
+			name: "credential_command",
+			data: AnthropicProviderModel{CredentialCommand: []types.String{types.StringValue("echo"), types.StringValue("sk-ant-admin01-command")}},
+			want: "sk-ant-admin01-command",
+		},
+		{
+			name:    "failing credential_command",
+			data:    AnthropicProviderModel{CredentialCommand: []types.String{types.StringValue("false")}},
+			wantErr: true,
+		},
+		{
+			name: "ANTHROPIC_ADMIN_API_KEY takes precedence",
+			env:  map[string]string{"ANTHROPIC_ADMIN_API_KEY": "sk-ant-admin01-admin", "ANTHROPIC_API_KEY": "sk-ant-api03-standard"},
+			want: "sk-ant-admin01-admin",
+		},
+		{
+			name: "admin ANTHROPIC_API_KEY",
+			env:  map[string]string{"ANTHROPIC_API_KEY": "sk-ant-admin01-env"},
+			want: "sk-ant-admin01-env",
+		},
+		{
+			name: "only standard ANTHROPIC_API_KEY",
+			env:  map[string]string{"ANTHROPIC_API_KEY": "sk-ant-api03-standard"},
+			want: "",
+		},
+		{
+			name:        "non-admin api_key",
+			data:        AnthropicProviderModel{ApiKey: types.StringValue("sk-ant-api03-attr")},
+			want:        "sk-ant-api03-attr",
+			wantWarning: true,
+		},
+		{
+			name: "missing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("ANTHROPIC_ADMIN_API_KEY", tc.env["ANTHROPIC_ADMIN_API_KEY"])
+			t.Setenv("ANTHROPIC_API_KEY", tc.env["ANTHROPIC_API_KEY"])
+
+			got, diags := resolveApiKey(context.Background(), tc.data)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("got diagnostics %v, want error %t", diags, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+			if hasWarning := diags.WarningsCount() > 0; !tc.wantErr && hasWarning != tc.wantWarning {
+				t.Errorf("got warning %t, want %t", hasWarning, tc.wantWarning)
+			}
+		})
+	}
+}
+
+func TestResolveInferenceApiKey(t *testing.T) {
+	testCases := []struct {
+		name string
+		data AnthropicProviderModel
+		env  string
+		want string
+	}{
+		{"inference_api_key", AnthropicProviderModel{InferenceApiKey: types.StringValue("sk-ant-api03-attr")}, "sk-ant-api03-env", "sk-ant-api03-attr"},
+		{"ANTHROPIC_API_KEY", AnthropicProviderModel{}, "sk-ant-api03-env", "sk-ant-api03-env"},
+		{"admin ANTHROPIC_API_KEY", AnthropicProviderModel{}, "sk-ant-admin01-env", ""},
+		{"missing", AnthropicProviderModel{}, "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("ANTHROPIC_API_KEY", tc.env)
+
+			if got := resolveInferenceApiKey(tc.data); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -8,26 +8,69 @@ import (
 	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
 )
 
+// baseDataSource is embedded by data sources that use the Admin API.
 type baseDataSource struct {
 	client *apiclient.ClientWithResponses
 }
 
 func (d *baseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := providerDataFromConfigureRequest(req, resp)
+	if !ok {
+		return
+	}
+
+	if data.Client == nil {
+		resp.Diagnostics.AddError(
+			"Admin API Key Required",
+			"This data source uses the Admin API, which requires an Admin API key. "+adminApiKeyRequiredDetail,
+		)
+
+		return
+	}
+
+	d.client = data.Client
+}
+
+// baseInferenceDataSource is embedded by data sources that use the standard
+// API.
+type baseInferenceDataSource struct {
+	client *apiclient.ClientWithResponses
+}
+
+func (d *baseInferenceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	data, ok := providerDataFromConfigureRequest(req, resp)
+	if !ok {
+		return
+	}
+
+	if data.InferenceClient == nil {
+		resp.Diagnostics.AddError(
+			"Inference API Key Required",
+			"This data source uses the standard Anthropic API, which requires a standard API key. "+inferenceApiKeyRequiredDetail,
+		)
+
+		return
+	}
+
+	d.client = data.InferenceClient
+}
+
+func providerDataFromConfigureRequest(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (*ProviderData, bool) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return
+		return nil, false
 	}
 
-	client, ok := req.ProviderData.(*apiclient.ClientWithResponses)
+	data, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *apiclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return
+		return nil, false
 	}
 
-	d.client = client
+	return data, true
 }
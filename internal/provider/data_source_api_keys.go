@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+type ApiKeysDataSourceModel struct {
+	WorkspaceId     types.String            `tfsdk:"workspace_id"`
+	Status          types.String            `tfsdk:"status"`
+	CreatedByUserId types.String            `tfsdk:"created_by_user_id"`
+	CreatedBefore   types.String            `tfsdk:"created_before"`
+	ApiKeys         []ApiKeyDataSourceModel `tfsdk:"api_keys"`
+}
+
+type ApiKeyDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Status         types.String `tfsdk:"status"`
+	WorkspaceId    types.String `tfsdk:"workspace_id"`
+	CreatedBy      types.String `tfsdk:"created_by"`
+	PartialKeyHint types.String `tfsdk:"partial_key_hint"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+func (m *ApiKeyDataSourceModel) Fill(data apiclient.ApiKey) error {
+	m.Id = types.StringValue(data.Id)
+	m.Name = types.StringValue(data.Name)
+	m.Status = types.StringValue(data.Status)
+	m.WorkspaceId = types.StringPointerValue(data.WorkspaceId)
+	m.CreatedBy = types.StringValue(data.CreatedBy.Id)
+	m.PartialKeyHint = types.StringPointerValue(data.PartialKeyHint)
+	m.CreatedAt = types.StringValue(data.CreatedAt)
+
+	return nil
+}
+
+func (m *ApiKeysDataSourceModel) Fill(apiKeys []apiclient.ApiKey) error {
+	m.ApiKeys = make([]ApiKeyDataSourceModel, len(apiKeys))
+	for i, k := range apiKeys {
+		if err := m.ApiKeys[i].Fill(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewApiKeysDataSource() datasource.DataSource {
+	return &ApiKeysDataSource{}
+}
+
+var _ datasource.DataSource = &ApiKeysDataSource{}
+
+type ApiKeysDataSource struct {
+	baseDataSource
+}
+
+func (d *ApiKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_keys"
+}
+
+func (d *ApiKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all API keys in the Organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Only return API keys belonging to this Workspace.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return API keys with this status. Must be one of `active`, `inactive`, or `archived`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "inactive", "archived"),
+				},
+			},
+			"created_by_user_id": schema.StringAttribute{
+				MarkdownDescription: "Only return API keys created by this User.",
+				Optional:            true,
+			},
+			"created_before": schema.StringAttribute{
+				MarkdownDescription: "Only return API keys created before this RFC 3339 datetime string. This filter is applied by the provider after the API keys are listed.",
+				Optional:            true,
+			},
+			"api_keys": schema.SetNestedAttribute{
+				MarkdownDescription: "List of API keys.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the API key.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the API key.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the API key.",
+							Computed:            true,
+						},
+						"workspace_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the Workspace associated with the API key, or null if the API key belongs to the default Workspace.",
+							Computed:            true,
+						},
+						"created_by": schema.StringAttribute{
+							MarkdownDescription: "ID of the actor that created the API key.",
+							Computed:            true,
+						},
+						"partial_key_hint": schema.StringAttribute{
+							MarkdownDescription: "Partially redacted hint for the API key.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "RFC 3339 datetime string indicating when the API key was created.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApiKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createdBefore time.Time
+	if !data.CreatedBefore.IsNull() {
+		var err error
+		createdBefore, err = time.Parse(time.RFC3339, data.CreatedBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("created_before"), "Invalid Attribute Value", fmt.Sprintf("Must be an RFC 3339 datetime string, got error: %s", err))
+			return
+		}
+	}
+
+	var apiKeys []apiclient.ApiKey
+	params := &apiclient.ListApiKeysParams{
+		Limit:           new(100),
+		WorkspaceId:     data.WorkspaceId.ValueStringPointer(),
+		Status:          data.Status.ValueStringPointer(),
+		CreatedByUserId: data.CreatedByUserId.ValueStringPointer(),
+	}
+
+	for {
+		httpResp, err := d.client.ListApiKeysWithResponse(
+			ctx,
+			params,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API keys, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read API keys", httpResp.HTTPResponse, httpResp.Body))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to read API keys, got empty response body")
+			return
+		}
+
+		for _, apiKey := range httpResp.JSON200.Data {
+			if !createdBefore.IsZero() {
+				createdAt, err := time.Parse(time.RFC3339, apiKey.CreatedAt)
+				if err != nil {
+					resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse created_at of API key %s, got error: %s", apiKey.Id, err))
+					return
+				}
+
+				if !createdAt.Before(createdBefore) {
+					continue
+				}
+			}
+
+			apiKeys = append(apiKeys, apiKey)
+		}
+
+		if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+			break
+		}
+
+		params.AfterId = httpResp.JSON200.LastId
+	}
+
+	if err := data.Fill(apiKeys); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+)
+
+func TestAccApiKeysDataSource(t *testing.T) {
+	rn := "data.anthropic_api_keys.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiKeysDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("status"), knownvalue.StringExact("active")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("api_keys"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccApiKeysDataSourceCreatedBeforeConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("api_keys"), knownvalue.SetExact([]knownvalue.Check{})),
+				},
+			},
+		},
+	})
+}
+
+var testAccApiKeysDataSourceConfig = `
+data "anthropic_api_keys" "test" {
+	status = "active"
+}
+`
+
+var testAccApiKeysDataSourceCreatedBeforeConfig = `
+data "anthropic_api_keys" "test" {
+	created_before = "2000-01-01T00:00:00Z"
+}
+`
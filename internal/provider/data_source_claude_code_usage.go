@@ -0,0 +1,415 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+// claudeCodeUsageDateLayout is the date format accepted and returned by the
+// Claude Code usage report.
+const claudeCodeUsageDateLayout = "2006-01-02"
+
+// claudeCodeUsageMaxDays is the longest range that can be reported on at once,
+// since every day in the range takes at least one request.
+const claudeCodeUsageMaxDays = 92
+
+type ClaudeCodeUsageDataSourceModel struct {
+	StartingAt types.String                 `tfsdk:"starting_at"`
+	EndingAt   types.String                 `tfsdk:"ending_at"`
+	Records    []ClaudeCodeUsageRecordModel `tfsdk:"records"`
+}
+
+type ClaudeCodeUsageRecordModel struct {
+	Date                     types.String                         `tfsdk:"date"`
+	ActorType                types.String                         `tfsdk:"actor_type"`
+	EmailAddress             types.String                         `tfsdk:"email_address"`
+	ApiKeyName               types.String                         `tfsdk:"api_key_name"`
+	OrganizationId           types.String                         `tfsdk:"organization_id"`
+	CustomerType             types.String                         `tfsdk:"customer_type"`
+	TerminalType             types.String                         `tfsdk:"terminal_type"`
+	SubscriptionType         types.String                         `tfsdk:"subscription_type"`
+	NumSessions              types.Int64                          `tfsdk:"num_sessions"`
+	LinesAdded               types.Int64                          `tfsdk:"lines_added"`
+	LinesRemoved             types.Int64                          `tfsdk:"lines_removed"`
+	Commits                  types.Int64                          `tfsdk:"commits"`
+	PullRequests             types.Int64                          `tfsdk:"pull_requests"`
+	EditToolAccepted         types.Int64                          `tfsdk:"edit_tool_accepted"`
+	EditToolRejected         types.Int64                          `tfsdk:"edit_tool_rejected"`
+	MultiEditToolAccepted    types.Int64                          `tfsdk:"multi_edit_tool_accepted"`
+	MultiEditToolRejected    types.Int64                          `tfsdk:"multi_edit_tool_rejected"`
+	NotebookEditToolAccepted types.Int64                          `tfsdk:"notebook_edit_tool_accepted"`
+	NotebookEditToolRejected types.Int64                          `tfsdk:"notebook_edit_tool_rejected"`
+	WriteToolAccepted        types.Int64                          `tfsdk:"write_tool_accepted"`
+	WriteToolRejected        types.Int64                          `tfsdk:"write_tool_rejected"`
+	ToolAcceptanceRate       types.Float64                        `tfsdk:"tool_acceptance_rate"`
+	EstimatedCostAmount      types.String                         `tfsdk:"estimated_cost_amount"`
+	EstimatedCostCurrency    types.String                         `tfsdk:"estimated_cost_currency"`
+	ModelBreakdown           []ClaudeCodeUsageModelBreakdownModel `tfsdk:"model_breakdown"`
+}
+
+type ClaudeCodeUsageModelBreakdownModel struct {
+	Model                 types.String `tfsdk:"model"`
+	InputTokens           types.Int64  `tfsdk:"input_tokens"`
+	OutputTokens          types.Int64  `tfsdk:"output_tokens"`
+	CacheReadTokens       types.Int64  `tfsdk:"cache_read_tokens"`
+	CacheCreationTokens   types.Int64  `tfsdk:"cache_creation_tokens"`
+	EstimatedCostAmount   types.String `tfsdk:"estimated_cost_amount"`
+	EstimatedCostCurrency types.String `tfsdk:"estimated_cost_currency"`
+}
+
+func (m *ClaudeCodeUsageRecordModel) Fill(r apiclient.ClaudeCodeUsageRecord) error {
+	m.Date = types.StringValue(r.Date)
+	m.ActorType = types.StringValue(r.Actor.Type)
+	m.EmailAddress = types.StringPointerValue(r.Actor.EmailAddress)
+	m.ApiKeyName = types.StringPointerValue(r.Actor.ApiKeyName)
+	m.OrganizationId = types.StringValue(r.OrganizationId)
+	m.CustomerType = types.StringValue(r.CustomerType)
+	m.TerminalType = types.StringValue(r.TerminalType)
+	m.SubscriptionType = types.StringPointerValue(r.SubscriptionType)
+	m.NumSessions = types.Int64Value(r.CoreMetrics.NumSessions)
+	m.LinesAdded = types.Int64Value(r.CoreMetrics.LinesOfCode.Added)
+	m.LinesRemoved = types.Int64Value(r.CoreMetrics.LinesOfCode.Removed)
+	m.Commits = types.Int64Value(r.CoreMetrics.CommitsByClaudeCode)
+	m.PullRequests = types.Int64Value(r.CoreMetrics.PullRequestsByClaudeCode)
+
+	var accepted, rejected int64
+	for _, action := range []struct {
+		src      *apiclient.ClaudeCodeToolAction
+		accepted *types.Int64
+		rejected *types.Int64
+	}{
+		{r.ToolActions.EditTool, &m.EditToolAccepted, &m.EditToolRejected},
+		{r.ToolActions.MultiEditTool, &m.MultiEditToolAccepted, &m.MultiEditToolRejected},
+		{r.ToolActions.NotebookEditTool, &m.NotebookEditToolAccepted, &m.NotebookEditToolRejected},
+		{r.ToolActions.WriteTool, &m.WriteToolAccepted, &m.WriteToolRejected},
+	} {
+		var a, b int64
+		if action.src != nil {
+			a, b = action.src.Accepted, action.src.Rejected
+		}
+		*action.accepted = types.Int64Value(a)
+		*action.rejected = types.Int64Value(b)
+		accepted += a
+		rejected += b
+	}
+
+	if accepted+rejected > 0 {
+		m.ToolAcceptanceRate = types.Float64Value(float64(accepted) / float64(accepted+rejected))
+	} else {
+		m.ToolAcceptanceRate = types.Float64Null()
+	}
+
+	// Amounts are summed as decimals, so that the total is exact. Amounts in
+	// different currencies cannot be summed, so the total is left null.
+	var amounts []string
+	var currency string
+	mixedCurrencies := false
+	m.ModelBreakdown = make([]ClaudeCodeUsageModelBreakdownModel, len(r.ModelBreakdown))
+	for i, b := range r.ModelBreakdown {
+		amount := strconv.FormatFloat(b.EstimatedCost.Amount, 'f', -1, 64)
+		m.ModelBreakdown[i] = ClaudeCodeUsageModelBreakdownModel{
+			Model:                 types.StringValue(b.Model),
+			InputTokens:           types.Int64Value(b.Tokens.Input),
+			OutputTokens:          types.Int64Value(b.Tokens.Output),
+			CacheReadTokens:       types.Int64Value(b.Tokens.CacheRead),
+			CacheCreationTokens:   types.Int64Value(b.Tokens.CacheCreation),
+			EstimatedCostAmount:   types.StringValue(amount),
+			EstimatedCostCurrency: types.StringValue(b.EstimatedCost.Currency),
+		}
+		amounts = append(amounts, amount)
+		if i > 0 && b.EstimatedCost.Currency != currency {
+			mixedCurrencies = true
+		}
+		currency = b.EstimatedCost.Currency
+	}
+
+	if len(amounts) == 0 || mixedCurrencies {
+		m.EstimatedCostAmount = types.StringNull()
+		m.EstimatedCostCurrency = types.StringNull()
+		return nil
+	}
+
+	total, err := sumDecimals(amounts)
+	if err != nil {
+		return err
+	}
+	m.EstimatedCostAmount = types.StringValue(total)
+	m.EstimatedCostCurrency = types.StringValue(currency)
+
+	return nil
+}
+
+// sumDecimals returns the exact sum of decimal strings, with no more decimal
+// places than the most precise of them.
+func sumDecimals(amounts []string) (string, error) {
+	total := new(big.Rat)
+	places := 0
+	for _, amount := range amounts {
+		v, ok := new(big.Rat).SetString(amount)
+		if !ok {
+			return "", fmt.Errorf("invalid decimal %q", amount)
+		}
+		total.Add(total, v)
+
+		if _, fraction, ok := strings.Cut(amount, "."); ok {
+			places = max(places, len(fraction))
+		}
+	}
+
+	return total.FloatString(places), nil
+}
+
+func (m *ClaudeCodeUsageDataSourceModel) Fill(records []apiclient.ClaudeCodeUsageRecord) error {
+	m.Records = make([]ClaudeCodeUsageRecordModel, len(records))
+	for i, r := range records {
+		if err := m.Records[i].Fill(r); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewClaudeCodeUsageDataSource() datasource.DataSource {
+	return &ClaudeCodeUsageDataSource{}
+}
+
+var _ datasource.DataSource = &ClaudeCodeUsageDataSource{}
+
+type ClaudeCodeUsageDataSource struct {
+	baseDataSource
+}
+
+func (d *ClaudeCodeUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_claude_code_usage"
+}
+
+func (d *ClaudeCodeUsageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	toolAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			MarkdownDescription: description,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get daily Claude Code usage analytics for each user and API key in the Organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"starting_at": schema.StringAttribute{
+				MarkdownDescription: "UTC date in `YYYY-MM-DD` format of the first day to report on.",
+				Required:            true,
+			},
+			"ending_at": schema.StringAttribute{
+				MarkdownDescription: "UTC date in `YYYY-MM-DD` format of the day after the last day to report on. Defaults to the day after `starting_at`, so that a single day is reported on. Must be at most 92 days after `starting_at`.",
+				Optional:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Usage records, one per actor and day.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							MarkdownDescription: "RFC 3339 datetime string indicating the day of the record.",
+							Computed:            true,
+						},
+						"actor_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the actor, either `user_actor` or `api_actor`.",
+							Computed:            true,
+						},
+						"email_address": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user, or null if the actor is an API key.",
+							Computed:            true,
+						},
+						"api_key_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the API key, or null if the actor is a user.",
+							Computed:            true,
+						},
+						"organization_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the Organization.",
+							Computed:            true,
+						},
+						"customer_type": schema.StringAttribute{
+							MarkdownDescription: "Type of customer, either `api` or `subscription`.",
+							Computed:            true,
+						},
+						"terminal_type": schema.StringAttribute{
+							MarkdownDescription: "Type of terminal Claude Code was used in.",
+							Computed:            true,
+						},
+						"subscription_type": schema.StringAttribute{
+							MarkdownDescription: "Subscription tier, or null for API customers.",
+							Computed:            true,
+						},
+						"num_sessions": schema.Int64Attribute{
+							MarkdownDescription: "Number of Claude Code sessions.",
+							Computed:            true,
+						},
+						"lines_added": schema.Int64Attribute{
+							MarkdownDescription: "Number of lines of code added.",
+							Computed:            true,
+						},
+						"lines_removed": schema.Int64Attribute{
+							MarkdownDescription: "Number of lines of code removed.",
+							Computed:            true,
+						},
+						"commits": schema.Int64Attribute{
+							MarkdownDescription: "Number of commits created by Claude Code.",
+							Computed:            true,
+						},
+						"pull_requests": schema.Int64Attribute{
+							MarkdownDescription: "Number of pull requests created by Claude Code.",
+							Computed:            true,
+						},
+						"edit_tool_accepted":          toolAttribute("Number of accepted Edit tool proposals."),
+						"edit_tool_rejected":          toolAttribute("Number of rejected Edit tool proposals."),
+						"multi_edit_tool_accepted":    toolAttribute("Number of accepted MultiEdit tool proposals."),
+						"multi_edit_tool_rejected":    toolAttribute("Number of rejected MultiEdit tool proposals."),
+						"notebook_edit_tool_accepted": toolAttribute("Number of accepted NotebookEdit tool proposals."),
+						"notebook_edit_tool_rejected": toolAttribute("Number of rejected NotebookEdit tool proposals."),
+						"write_tool_accepted":         toolAttribute("Number of accepted Write tool proposals."),
+						"write_tool_rejected":         toolAttribute("Number of rejected Write tool proposals."),
+						"tool_acceptance_rate": schema.Float64Attribute{
+							MarkdownDescription: "Fraction of tool proposals that were accepted, between 0 and 1, or null if there were no tool proposals.",
+							Computed:            true,
+						},
+						"estimated_cost_amount": schema.StringAttribute{
+							MarkdownDescription: "Total estimated cost across all models in the lowest units of `estimated_cost_currency` (e.g. cents), as a decimal string to avoid loss of precision. Null if no models were used, or if the models were billed in different currencies.",
+							Computed:            true,
+						},
+						"estimated_cost_currency": schema.StringAttribute{
+							MarkdownDescription: "Currency code of `estimated_cost_amount`, or null if no models were used, or if the models were billed in different currencies.",
+							Computed:            true,
+						},
+						"model_breakdown": schema.ListNestedAttribute{
+							MarkdownDescription: "Token usage and estimated cost per model.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"model": schema.StringAttribute{
+										MarkdownDescription: "Model used.",
+										Computed:            true,
+									},
+									"input_tokens": schema.Int64Attribute{
+										MarkdownDescription: "Number of input tokens.",
+										Computed:            true,
+									},
+									"output_tokens": schema.Int64Attribute{
+										MarkdownDescription: "Number of output tokens.",
+										Computed:            true,
+									},
+									"cache_read_tokens": schema.Int64Attribute{
+										MarkdownDescription: "Number of input tokens read from the cache.",
+										Computed:            true,
+									},
+									"cache_creation_tokens": schema.Int64Attribute{
+										MarkdownDescription: "Number of input tokens used to create cache entries.",
+										Computed:            true,
+									},
+									"estimated_cost_amount": schema.StringAttribute{
+										MarkdownDescription: "Estimated cost in the lowest units of `estimated_cost_currency` (e.g. cents), as a decimal string to avoid loss of precision.",
+										Computed:            true,
+									},
+									"estimated_cost_currency": schema.StringAttribute{
+										MarkdownDescription: "Currency code of `estimated_cost_amount`.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ClaudeCodeUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ClaudeCodeUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	startingAt, err := time.Parse(claudeCodeUsageDateLayout, data.StartingAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("starting_at"), "Invalid Attribute Value", fmt.Sprintf("Must be a date in YYYY-MM-DD format, got error: %s", err))
+		return
+	}
+
+	endingAt := startingAt.AddDate(0, 0, 1)
+	if !data.EndingAt.IsNull() {
+		endingAt, err = time.Parse(claudeCodeUsageDateLayout, data.EndingAt.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ending_at"), "Invalid Attribute Value", fmt.Sprintf("Must be a date in YYYY-MM-DD format, got error: %s", err))
+			return
+		}
+
+		if !endingAt.After(startingAt) {
+			resp.Diagnostics.AddAttributeError(path.Root("ending_at"), "Invalid Attribute Value", "Must be after starting_at.")
+			return
+		}
+
+		if endingAt.After(startingAt.AddDate(0, 0, claudeCodeUsageMaxDays)) {
+			resp.Diagnostics.AddAttributeError(path.Root("ending_at"), "Invalid Attribute Value", fmt.Sprintf("Must be at most %d days after starting_at.", claudeCodeUsageMaxDays))
+			return
+		}
+	}
+
+	// The report covers a single day per request, so each day in the range is
+	// requested in turn.
+	var records []apiclient.ClaudeCodeUsageRecord
+	for day := startingAt; day.Before(endingAt); day = day.AddDate(0, 0, 1) {
+		params := &apiclient.GetClaudeCodeUsageReportParams{
+			StartingAt: day.Format(claudeCodeUsageDateLayout),
+			Limit:      new(1000),
+		}
+
+		for {
+			httpResp, err := d.client.GetClaudeCodeUsageReportWithResponse(
+				ctx,
+				params,
+			)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Claude Code usage, got error: %s", err))
+				return
+			}
+
+			if httpResp.StatusCode() != 200 {
+				resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read Claude Code usage", httpResp.HTTPResponse, httpResp.Body))
+				return
+			}
+
+			if httpResp.JSON200 == nil {
+				resp.Diagnostics.AddError("Client Error", "Unable to read Claude Code usage, got empty response body")
+				return
+			}
+
+			records = append(records, httpResp.JSON200.Data...)
+
+			if !httpResp.JSON200.HasMore || httpResp.JSON200.NextPage == nil {
+				break
+			}
+
+			params.Page = httpResp.JSON200.NextPage
+		}
+	}
+
+	if err := data.Fill(records); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func TestAccClaudeCodeUsageDataSource(t *testing.T) {
+	rn := "data.anthropic_claude_code_usage.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClaudeCodeUsageDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("starting_at"), knownvalue.StringExact("2025-09-01")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("records"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+var testAccClaudeCodeUsageDataSourceConfig = `
+data "anthropic_claude_code_usage" "test" {
+	starting_at = "2025-09-01"
+	ending_at   = "2025-09-03"
+}
+`
+
+func TestAccClaudeCodeUsageDataSource_maxDays(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClaudeCodeUsageDataSourceMaxDaysConfig,
+				ExpectError: regexp.MustCompile("Must be at most 92 days after starting_at"),
+			},
+		},
+	})
+}
+
+var testAccClaudeCodeUsageDataSourceMaxDaysConfig = `
+data "anthropic_claude_code_usage" "test" {
+	starting_at = "2025-09-01"
+	ending_at   = "2026-09-01"
+}
+`
+
+func TestClaudeCodeUsageRecordModel_Fill_estimatedCost(t *testing.T) {
+	testCases := []struct {
+		name         string
+		breakdown    string
+		wantAmount   types.String
+		wantCurrency types.String
+	}{
+		{"none", `[]`, types.StringNull(), types.StringNull()},
+		{"exact sum", `[{"estimated_cost":{"currency":"USD","amount":0.1}},{"estimated_cost":{"currency":"USD","amount":0.2}}]`, types.StringValue("0.3"), types.StringValue("USD")},
+		{"whole", `[{"estimated_cost":{"currency":"USD","amount":150}},{"estimated_cost":{"currency":"USD","amount":25.5}}]`, types.StringValue("175.5"), types.StringValue("USD")},
+		{"mixed currencies", `[{"estimated_cost":{"currency":"USD","amount":150}},{"estimated_cost":{"currency":"EUR","amount":25}}]`, types.StringNull(), types.StringNull()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var r apiclient.ClaudeCodeUsageRecord
+			if err := json.Unmarshal([]byte(`{"model_breakdown":`+tc.breakdown+`}`), &r); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var m ClaudeCodeUsageRecordModel
+			if err := m.Fill(r); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !m.EstimatedCostAmount.Equal(tc.wantAmount) || !m.EstimatedCostCurrency.Equal(tc.wantCurrency) {
+				t.Errorf("got %s %s, want %s %s", m.EstimatedCostAmount, m.EstimatedCostCurrency, tc.wantAmount, tc.wantCurrency)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+type CostReportDataSourceModel struct {
+	StartingAt types.String            `tfsdk:"starting_at"`
+	EndingAt   types.String            `tfsdk:"ending_at"`
+	GroupBy    []types.String          `tfsdk:"group_by"`
+	Results    []CostReportResultModel `tfsdk:"results"`
+}
+
+type CostReportResultModel struct {
+	StartingAt    types.String `tfsdk:"starting_at"`
+	EndingAt      types.String `tfsdk:"ending_at"`
+	Amount        types.String `tfsdk:"amount"`
+	Currency      types.String `tfsdk:"currency"`
+	WorkspaceId   types.String `tfsdk:"workspace_id"`
+	Description   types.String `tfsdk:"description"`
+	CostType      types.String `tfsdk:"cost_type"`
+	ContextWindow types.String `tfsdk:"context_window"`
+	Model         types.String `tfsdk:"model"`
+	ServiceTier   types.String `tfsdk:"service_tier"`
+	TokenType     types.String `tfsdk:"token_type"`
+}
+
+func (m *CostReportResultModel) Fill(bucket apiclient.CostReportBucket, r apiclient.CostReportResult) error {
+	m.StartingAt = types.StringValue(bucket.StartingAt)
+	m.EndingAt = types.StringValue(bucket.EndingAt)
+	m.Amount = types.StringValue(r.Amount)
+	m.Currency = types.StringValue(r.Currency)
+	m.WorkspaceId = types.StringPointerValue(r.WorkspaceId)
+	m.Description = types.StringPointerValue(r.Description)
+	m.CostType = types.StringPointerValue(r.CostType)
+	m.ContextWindow = types.StringPointerValue(r.ContextWindow)
+	m.Model = types.StringPointerValue(r.Model)
+	m.ServiceTier = types.StringPointerValue(r.ServiceTier)
+	m.TokenType = types.StringPointerValue(r.TokenType)
+
+	return nil
+}
+
+// Fill flattens the time buckets so that each result carries the bounds of
+// the bucket it belongs to.
+func (m *CostReportDataSourceModel) Fill(buckets []apiclient.CostReportBucket) error {
+	m.Results = []CostReportResultModel{}
+	for _, bucket := range buckets {
+		for _, r := range bucket.Results {
+			var result CostReportResultModel
+			if err := result.Fill(bucket, r); err != nil {
+				return err
+			}
+			m.Results = append(m.Results, result)
+		}
+	}
+
+	return nil
+}
+
+func NewCostReportDataSource() datasource.DataSource {
+	return &CostReportDataSource{}
+}
+
+var _ datasource.DataSource = &CostReportDataSource{}
+
+type CostReportDataSource struct {
+	baseDataSource
+}
+
+func (d *CostReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cost_report"
+}
+
+func (d *CostReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the daily cost report for the Organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"starting_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 datetime string. Time buckets that start on or after this time are returned.",
+				Required:            true,
+			},
+			"ending_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 datetime string. Time buckets that end before this time are returned.",
+				Optional:            true,
+			},
+			"group_by": schema.ListAttribute{
+				MarkdownDescription: "Group the costs by the given dimensions. Each must be one of `workspace_id` or `description`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("workspace_id", "description"),
+					),
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Cost results, one per daily time bucket and group.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"starting_at": schema.StringAttribute{
+							MarkdownDescription: "RFC 3339 datetime string indicating the start of the time bucket.",
+							Computed:            true,
+						},
+						"ending_at": schema.StringAttribute{
+							MarkdownDescription: "RFC 3339 datetime string indicating the end of the time bucket.",
+							Computed:            true,
+						},
+						"amount": schema.StringAttribute{
+							MarkdownDescription: "Cost in the lowest units of `currency` (e.g. cents), as a decimal string to avoid loss of precision.",
+							Computed:            true,
+						},
+						"currency": schema.StringAttribute{
+							MarkdownDescription: "Currency code of `amount`, e.g. `USD`.",
+							Computed:            true,
+						},
+						"workspace_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the Workspace, or null if not grouped by `workspace_id`.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the cost item, or null if not grouped by `description`.",
+							Computed:            true,
+						},
+						"cost_type": schema.StringAttribute{
+							MarkdownDescription: "Type of cost, e.g. `tokens` or `web_search`. Only set when grouped by `description`.",
+							Computed:            true,
+						},
+						"context_window": schema.StringAttribute{
+							MarkdownDescription: "Context window. Only set when grouped by `description`.",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "Model used. Only set when grouped by `description`.",
+							Computed:            true,
+						},
+						"service_tier": schema.StringAttribute{
+							MarkdownDescription: "Service tier. Only set when grouped by `description`.",
+							Computed:            true,
+						},
+						"token_type": schema.StringAttribute{
+							MarkdownDescription: "Type of token. Only set when grouped by `description`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CostReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CostReportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var buckets []apiclient.CostReportBucket
+	params := &apiclient.GetCostReportParams{
+		StartingAt: data.StartingAt.ValueString(),
+		EndingAt:   data.EndingAt.ValueStringPointer(),
+		GroupBy:    ExpandStringList(data.GroupBy),
+	}
+
+	for {
+		httpResp, err := d.client.GetCostReportWithResponse(
+			ctx,
+			params,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cost report, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read cost report", httpResp.HTTPResponse, httpResp.Body))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to read cost report, got empty response body")
+			return
+		}
+
+		buckets = append(buckets, httpResp.JSON200.Data...)
+
+		if !httpResp.JSON200.HasMore || httpResp.JSON200.NextPage == nil {
+			break
+		}
+
+		params.Page = httpResp.JSON200.NextPage
+	}
+
+	if err := data.Fill(buckets); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
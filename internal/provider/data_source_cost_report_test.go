@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func TestAccCostReportDataSource(t *testing.T) {
+	rn := "data.anthropic_cost_report.test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("starting_at"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("results"), knownvalue.NotNull()),
+	}
+	if acctest.TestFakeApi {
+		// The fake API reports the same costs of two models every day, over
+		// two pages of seven days.
+		checks = append(checks,
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("results"), knownvalue.ListSizeExact(28)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("results").AtSliceIndex(0), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+				"starting_at": knownvalue.StringExact("2025-01-01T00:00:00Z"),
+				"ending_at":   knownvalue.StringExact("2025-01-02T00:00:00Z"),
+				"amount":      knownvalue.StringExact("300"),
+				"currency":    knownvalue.StringExact("USD"),
+				"description": knownvalue.StringExact("Claude Sonnet 4.5 Usage - Input Tokens"),
+			})),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("results").AtSliceIndex(27), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+				"starting_at": knownvalue.StringExact("2025-01-14T00:00:00Z"),
+				"ending_at":   knownvalue.StringExact("2025-01-15T00:00:00Z"),
+				"amount":      knownvalue.StringExact("320"),
+				"currency":    knownvalue.StringExact("USD"),
+				"description": knownvalue.StringExact("Claude Haiku 3.5 Usage - Input Tokens"),
+			})),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:            testAccCostReportDataSourceConfig,
+				ConfigStateChecks: checks,
+			},
+		},
+	})
+}
+
+var testAccCostReportDataSourceConfig = `
+data "anthropic_cost_report" "test" {
+	starting_at = "2025-01-01T00:00:00Z"
+	ending_at   = "2025-01-15T00:00:00Z"
+	group_by    = ["workspace_id", "description"]
+}
+`
+
+func TestCostReportDataSourceModel_Fill(t *testing.T) {
+	buckets := []apiclient.CostReportBucket{
+		{
+			StartingAt: "2025-01-01T00:00:00Z",
+			EndingAt:   "2025-01-02T00:00:00Z",
+			Results: []apiclient.CostReportResult{
+				{Amount: "300", Currency: "USD", Description: new("Claude Sonnet 4.5 Usage - Input Tokens"), CostType: new("tokens"), Model: new("claude-sonnet-4-5-20250929"), TokenType: new("uncached_input_tokens")},
+				{Amount: "12.5", Currency: "USD", WorkspaceId: new("wrkspc_test"), Description: new("Web Search"), CostType: new("web_search")},
+			},
+		},
+		{
+			StartingAt: "2025-01-02T00:00:00Z",
+			EndingAt:   "2025-01-03T00:00:00Z",
+			Results:    []apiclient.CostReportResult{},
+		},
+		{
+			StartingAt: "2025-01-03T00:00:00Z",
+			EndingAt:   "2025-01-04T00:00:00Z",
+			Results: []apiclient.CostReportResult{
+				{Amount: "0.25", Currency: "USD"},
+			},
+		},
+	}
+
+	var m CostReportDataSourceModel
+	if err := m.Fill(buckets); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []CostReportResultModel{
+		{
+			StartingAt:    types.StringValue("2025-01-01T00:00:00Z"),
+			EndingAt:      types.StringValue("2025-01-02T00:00:00Z"),
+			Amount:        types.StringValue("300"),
+			Currency:      types.StringValue("USD"),
+			WorkspaceId:   types.StringNull(),
+			Description:   types.StringValue("Claude Sonnet 4.5 Usage - Input Tokens"),
+			CostType:      types.StringValue("tokens"),
+			ContextWindow: types.StringNull(),
+			Model:         types.StringValue("claude-sonnet-4-5-20250929"),
+			ServiceTier:   types.StringNull(),
+			TokenType:     types.StringValue("uncached_input_tokens"),
+		},
+		{
+			StartingAt:    types.StringValue("2025-01-01T00:00:00Z"),
+			EndingAt:      types.StringValue("2025-01-02T00:00:00Z"),
+			Amount:        types.StringValue("12.5"),
+			Currency:      types.StringValue("USD"),
+			WorkspaceId:   types.StringValue("wrkspc_test"),
+			Description:   types.StringValue("Web Search"),
+			CostType:      types.StringValue("web_search"),
+			ContextWindow: types.StringNull(),
+			Model:         types.StringNull(),
+			ServiceTier:   types.StringNull(),
+			TokenType:     types.StringNull(),
+		},
+		{
+			StartingAt:    types.StringValue("2025-01-03T00:00:00Z"),
+			EndingAt:      types.StringValue("2025-01-04T00:00:00Z"),
+			Amount:        types.StringValue("0.25"),
+			Currency:      types.StringValue("USD"),
+			WorkspaceId:   types.StringNull(),
+			Description:   types.StringNull(),
+			CostType:      types.StringNull(),
+			ContextWindow: types.StringNull(),
+			Model:         types.StringNull(),
+			ServiceTier:   types.StringNull(),
+			TokenType:     types.StringNull(),
+		},
+	}
+	if !reflect.DeepEqual(m.Results, want) {
+		t.Errorf("got results %+v, want %+v", m.Results, want)
+	}
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+type ModelDataSourceModel struct {
+	Model       types.String `tfsdk:"model"`
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (m *ModelDataSourceModel) Fill(model apiclient.Model) error {
+	m.Id = types.StringValue(model.Id)
+	m.DisplayName = types.StringValue(model.DisplayName)
+	m.CreatedAt = types.StringValue(model.CreatedAt)
+
+	return nil
+}
+
+func NewModelDataSource() datasource.DataSource {
+	return &ModelDataSource{}
+}
+
+var _ datasource.DataSource = &ModelDataSource{}
+
+type ModelDataSource struct {
+	baseInferenceDataSource
+}
+
+func (d *ModelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_model"
+}
+
+func (d *ModelDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get a model. Model aliases such as `claude-sonnet-4-5` are resolved to their concrete model ID. Requires a standard API key, see the `inference_api_key` provider attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Model ID or alias to look up.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Concrete ID of the model that `model` resolves to.",
+				Computed:            true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Human-readable name of the model.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 datetime string indicating when the model was released.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ModelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := d.client.GetModelWithResponse(
+		ctx,
+		data.Model.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("model"), "Model Not Found", fmt.Sprintf("No model with ID or alias %q is available.", data.Model.ValueString()))
+		return
+	}
+
+	if httpResp.StatusCode() != 200 {
+		resp.Diagnostics.Append(NewInferenceApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
+		return
+	}
+
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
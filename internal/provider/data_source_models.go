@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+type ModelsDataSourceModel struct {
+	Models []ModelInfoModel `tfsdk:"models"`
+}
+
+type ModelInfoModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (m *ModelsDataSourceModel) Fill(models []apiclient.Model) error {
+	m.Models = make([]ModelInfoModel, len(models))
+	for i, model := range models {
+		m.Models[i] = ModelInfoModel{
+			Id:          types.StringValue(model.Id),
+			DisplayName: types.StringValue(model.DisplayName),
+			CreatedAt:   types.StringValue(model.CreatedAt),
+		}
+	}
+
+	return nil
+}
+
+func NewModelsDataSource() datasource.DataSource {
+	return &ModelsDataSource{}
+}
+
+var _ datasource.DataSource = &ModelsDataSource{}
+
+type ModelsDataSource struct {
+	baseInferenceDataSource
+}
+
+func (d *ModelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_models"
+}
+
+func (d *ModelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all models available to the Organization. Requires a standard API key, see the `inference_api_key` provider attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			"models": schema.SetNestedAttribute{
+				MarkdownDescription: "List of models.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the model.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Human-readable name of the model.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "RFC 3339 datetime string indicating when the model was released.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ModelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ModelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var models []apiclient.Model
+	params := &apiclient.ListModelsParams{
+		Limit: new(100),
+	}
+
+	for {
+		httpResp, err := d.client.ListModelsWithResponse(
+			ctx,
+			params,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.Append(NewInferenceApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
+			return
+		}
+
+		models = append(models, httpResp.JSON200.Data...)
+
+		if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+			break
+		}
+
+		params.AfterId = httpResp.JSON200.LastId
+	}
+
+	if err := data.Fill(models); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+)
+
+func TestAccModelsDataSource(t *testing.T) {
+	rn := "data.anthropic_models.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheckInferenceApiKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelsDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("models"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"id":           knownvalue.StringRegexp(regexp.MustCompile(`^claude-sonnet-4-5-\d{8}$`)),
+							"display_name": knownvalue.NotNull(),
+							"created_at":   knownvalue.NotNull(),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+var testAccModelsDataSourceConfig = `
+data "anthropic_models" "test" {
+}
+`
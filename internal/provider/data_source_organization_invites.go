@@ -117,7 +117,7 @@ func (d *OrganizationInvitesDataSource) Read(ctx context.Context, req datasource
 		}
 
 		if httpResp.StatusCode() != 200 {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read invites, got status code: %d", httpResp.StatusCode()))
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read invites", httpResp.HTTPResponse, httpResp.Body))
 			return
 		}
 
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+type UsageReportDataSourceModel struct {
+	StartingAt   types.String             `tfsdk:"starting_at"`
+	EndingAt     types.String             `tfsdk:"ending_at"`
+	BucketWidth  types.String             `tfsdk:"bucket_width"`
+	GroupBy      []types.String           `tfsdk:"group_by"`
+	Models       []types.String           `tfsdk:"models"`
+	WorkspaceIds []types.String           `tfsdk:"workspace_ids"`
+	ApiKeyIds    []types.String           `tfsdk:"api_key_ids"`
+	Results      []UsageReportResultModel `tfsdk:"results"`
+}
+
+type UsageReportResultModel struct {
+	StartingAt                          types.String `tfsdk:"starting_at"`
+	EndingAt                            types.String `tfsdk:"ending_at"`
+	UncachedInputTokens                 types.Int64  `tfsdk:"uncached_input_tokens"`
+	CacheCreationEphemeral1hInputTokens types.Int64  `tfsdk:"cache_creation_ephemeral_1h_input_tokens"`
+	CacheCreationEphemeral5mInputTokens types.Int64  `tfsdk:"cache_creation_ephemeral_5m_input_tokens"`
+	CacheReadInputTokens                types.Int64  `tfsdk:"cache_read_input_tokens"`
+	OutputTokens                        types.Int64  `tfsdk:"output_tokens"`
+	WebSearchRequests                   types.Int64  `tfsdk:"web_search_requests"`
+	ApiKeyId                            types.String `tfsdk:"api_key_id"`
+	WorkspaceId                         types.String `tfsdk:"workspace_id"`
+	Model                               types.String `tfsdk:"model"`
+	ServiceTier                         types.String `tfsdk:"service_tier"`
+	ContextWindow                       types.String `tfsdk:"context_window"`
+}
+
+func (m *UsageReportResultModel) Fill(bucket apiclient.MessagesUsageReportBucket, r apiclient.MessagesUsageReportResult) error {
+	m.StartingAt = types.StringValue(bucket.StartingAt)
+	m.EndingAt = types.StringValue(bucket.EndingAt)
+	m.UncachedInputTokens = types.Int64Value(r.UncachedInputTokens)
+	m.CacheCreationEphemeral1hInputTokens = types.Int64Value(r.CacheCreation.Ephemeral1hInputTokens)
+	m.CacheCreationEphemeral5mInputTokens = types.Int64Value(r.CacheCreation.Ephemeral5mInputTokens)
+	m.CacheReadInputTokens = types.Int64Value(r.CacheReadInputTokens)
+	m.OutputTokens = types.Int64Value(r.OutputTokens)
+	m.WebSearchRequests = types.Int64Value(r.ServerToolUse.WebSearchRequests)
+	m.ApiKeyId = types.StringPointerValue(r.ApiKeyId)
+	m.WorkspaceId = types.StringPointerValue(r.WorkspaceId)
+	m.Model = types.StringPointerValue(r.Model)
+	m.ServiceTier = types.StringPointerValue(r.ServiceTier)
+	m.ContextWindow = types.StringPointerValue(r.ContextWindow)
+
+	return nil
+}
+
+// Fill flattens the time buckets so that each result carries the bounds of
+// the bucket it belongs to.
+func (m *UsageReportDataSourceModel) Fill(buckets []apiclient.MessagesUsageReportBucket) error {
+	m.Results = []UsageReportResultModel{}
+	for _, bucket := range buckets {
+		for _, r := range bucket.Results {
+			var result UsageReportResultModel
+			if err := result.Fill(bucket, r); err != nil {
+				return err
+			}
+			m.Results = append(m.Results, result)
+		}
+	}
+
+	return nil
+}
+
+func NewUsageReportDataSource() datasource.DataSource {
+	return &UsageReportDataSource{}
+}
+
+var _ datasource.DataSource = &UsageReportDataSource{}
+
+type UsageReportDataSource struct {
+	baseDataSource
+}
+
+func (d *UsageReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage_report"
+}
+
+func (d *UsageReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the token usage report for the Messages API in the Organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"starting_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 datetime string. Time buckets that start on or after this time are returned.",
+				Required:            true,
+			},
+			"ending_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 datetime string. Time buckets that end before this time are returned.",
+				Optional:            true,
+			},
+			"bucket_width": schema.StringAttribute{
+				MarkdownDescription: "Width of the time buckets. Must be one of `1m`, `1h`, or `1d`. Defaults to `1d`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1m", "1h", "1d"),
+				},
+			},
+			"group_by": schema.ListAttribute{
+				MarkdownDescription: "Group the usage by the given dimensions. Each must be one of `model`, `workspace_id`, `api_key_id`, `service_tier`, or `context_window`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("model", "workspace_id", "api_key_id", "service_tier", "context_window"),
+					),
+				},
+			},
+			"models": schema.ListAttribute{
+				MarkdownDescription: "Only include usage for these models.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"workspace_ids": schema.ListAttribute{
+				MarkdownDescription: "Only include usage for these Workspaces.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"api_key_ids": schema.ListAttribute{
+				MarkdownDescription: "Only include usage for these API keys.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "Usage results, one per time bucket and group.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"starting_at": schema.StringAttribute{
+							MarkdownDescription: "RFC 3339 datetime string indicating the start of the time bucket.",
+							Computed:            true,
+						},
+						"ending_at": schema.StringAttribute{
+							MarkdownDescription: "RFC 3339 datetime string indicating the end of the time bucket.",
+							Computed:            true,
+						},
+						"uncached_input_tokens": schema.Int64Attribute{
+							MarkdownDescription: "Number of uncached input tokens.",
+							Computed:            true,
+						},
+						"cache_creation_ephemeral_1h_input_tokens": schema.Int64Attribute{
+							MarkdownDescription: "Number of input tokens used to create 1 hour cache entries.",
+							Computed:            true,
+						},
+						"cache_creation_ephemeral_5m_input_tokens": schema.Int64Attribute{
+							MarkdownDescription: "Number of input tokens used to create 5 minute cache entries.",
+							Computed:            true,
+						},
+						"cache_read_input_tokens": schema.Int64Attribute{
+							MarkdownDescription: "Number of input tokens read from the cache.",
+							Computed:            true,
+						},
+						"output_tokens": schema.Int64Attribute{
+							MarkdownDescription: "Number of output tokens.",
+							Computed:            true,
+						},
+						"web_search_requests": schema.Int64Attribute{
+							MarkdownDescription: "Number of web search requests made by the server tool.",
+							Computed:            true,
+						},
+						"api_key_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the API key, or null if not grouped by `api_key_id`.",
+							Computed:            true,
+						},
+						"workspace_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the Workspace, or null if not grouped by `workspace_id`.",
+							Computed:            true,
+						},
+						"model": schema.StringAttribute{
+							MarkdownDescription: "Model used, or null if not grouped by `model`.",
+							Computed:            true,
+						},
+						"service_tier": schema.StringAttribute{
+							MarkdownDescription: "Service tier, or null if not grouped by `service_tier`.",
+							Computed:            true,
+						},
+						"context_window": schema.StringAttribute{
+							MarkdownDescription: "Context window, or null if not grouped by `context_window`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsageReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsageReportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var buckets []apiclient.MessagesUsageReportBucket
+	params := &apiclient.GetMessagesUsageReportParams{
+		StartingAt:   data.StartingAt.ValueString(),
+		EndingAt:     data.EndingAt.ValueStringPointer(),
+		BucketWidth:  data.BucketWidth.ValueStringPointer(),
+		GroupBy:      ExpandStringList(data.GroupBy),
+		Models:       ExpandStringList(data.Models),
+		WorkspaceIds: ExpandStringList(data.WorkspaceIds),
+		ApiKeyIds:    ExpandStringList(data.ApiKeyIds),
+	}
+
+	for {
+		httpResp, err := d.client.GetMessagesUsageReportWithResponse(
+			ctx,
+			params,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read usage report, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != 200 {
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read usage report", httpResp.HTTPResponse, httpResp.Body))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to read usage report, got empty response body")
+			return
+		}
+
+		buckets = append(buckets, httpResp.JSON200.Data...)
+
+		if !httpResp.JSON200.HasMore || httpResp.JSON200.NextPage == nil {
+			break
+		}
+
+		params.Page = httpResp.JSON200.NextPage
+	}
+
+	if err := data.Fill(buckets); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
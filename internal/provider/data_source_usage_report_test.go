@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func TestAccUsageReportDataSource(t *testing.T) {
+	rn := "data.anthropic_usage_report.test"
+
+	checks := []statecheck.StateCheck{
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("starting_at"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+		statecheck.ExpectKnownValue(rn, tfjsonpath.New("results"), knownvalue.NotNull()),
+	}
+	if acctest.TestFakeApi {
+		// The fake API reports the same usage of two models every day, over
+		// two pages of seven days.
+		checks = append(checks,
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("results"), knownvalue.ListSizeExact(28)),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("results").AtSliceIndex(0), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+				"starting_at":           knownvalue.StringExact("2025-01-01T00:00:00Z"),
+				"ending_at":             knownvalue.StringExact("2025-01-02T00:00:00Z"),
+				"model":                 knownvalue.StringExact("claude-sonnet-4-5-20250929"),
+				"uncached_input_tokens": knownvalue.Int64Exact(1000),
+				"output_tokens":         knownvalue.Int64Exact(200),
+			})),
+			statecheck.ExpectKnownValue(rn, tfjsonpath.New("results").AtSliceIndex(27), knownvalue.ObjectPartial(map[string]knownvalue.Check{
+				"starting_at":           knownvalue.StringExact("2025-01-14T00:00:00Z"),
+				"ending_at":             knownvalue.StringExact("2025-01-15T00:00:00Z"),
+				"model":                 knownvalue.StringExact("claude-3-5-haiku-20241022"),
+				"uncached_input_tokens": knownvalue.Int64Exact(4000),
+				"output_tokens":         knownvalue.Int64Exact(800),
+			})),
+		)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:            testAccUsageReportDataSourceConfig,
+				ConfigStateChecks: checks,
+			},
+		},
+	})
+}
+
+var testAccUsageReportDataSourceConfig = `
+data "anthropic_usage_report" "test" {
+	starting_at  = "2025-01-01T00:00:00Z"
+	ending_at    = "2025-01-15T00:00:00Z"
+	bucket_width = "1d"
+	group_by     = ["model", "workspace_id"]
+}
+`
+
+func TestUsageReportDataSourceModel_Fill(t *testing.T) {
+	var buckets []apiclient.MessagesUsageReportBucket
+	if err := json.Unmarshal([]byte(`[
+		{"starting_at":"2025-01-01T00:00:00Z","ending_at":"2025-01-02T00:00:00Z","results":[
+			{"uncached_input_tokens":1000,"cache_creation":{"ephemeral_1h_input_tokens":10,"ephemeral_5m_input_tokens":20},"cache_read_input_tokens":30,"output_tokens":200,"server_tool_use":{"web_search_requests":2},"model":"claude-sonnet-4-5-20250929","workspace_id":null},
+			{"uncached_input_tokens":4000,"cache_creation":{"ephemeral_1h_input_tokens":0,"ephemeral_5m_input_tokens":0},"cache_read_input_tokens":0,"output_tokens":800,"server_tool_use":{"web_search_requests":0},"model":"claude-3-5-haiku-20241022","workspace_id":"wrkspc_test"}
+		]},
+		{"starting_at":"2025-01-02T00:00:00Z","ending_at":"2025-01-03T00:00:00Z","results":[]},
+		{"starting_at":"2025-01-03T00:00:00Z","ending_at":"2025-01-04T00:00:00Z","results":[
+			{"uncached_input_tokens":5,"cache_creation":{"ephemeral_1h_input_tokens":0,"ephemeral_5m_input_tokens":0},"cache_read_input_tokens":0,"output_tokens":1,"server_tool_use":{"web_search_requests":0},"model":"claude-sonnet-4-5-20250929","workspace_id":null}
+		]}
+	]`), &buckets); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var m UsageReportDataSourceModel
+	if err := m.Fill(buckets); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []UsageReportResultModel{
+		{
+			StartingAt:                          types.StringValue("2025-01-01T00:00:00Z"),
+			EndingAt:                            types.StringValue("2025-01-02T00:00:00Z"),
+			UncachedInputTokens:                 types.Int64Value(1000),
+			CacheCreationEphemeral1hInputTokens: types.Int64Value(10),
+			CacheCreationEphemeral5mInputTokens: types.Int64Value(20),
+			CacheReadInputTokens:                types.Int64Value(30),
+			OutputTokens:                        types.Int64Value(200),
+			WebSearchRequests:                   types.Int64Value(2),
+			ApiKeyId:                            types.StringNull(),
+			WorkspaceId:                         types.StringNull(),
+			Model:                               types.StringValue("claude-sonnet-4-5-20250929"),
+			ServiceTier:                         types.StringNull(),
+			ContextWindow:                       types.StringNull(),
+		},
+		{
+			StartingAt:                          types.StringValue("2025-01-01T00:00:00Z"),
+			EndingAt:                            types.StringValue("2025-01-02T00:00:00Z"),
+			UncachedInputTokens:                 types.Int64Value(4000),
+			CacheCreationEphemeral1hInputTokens: types.Int64Value(0),
+			CacheCreationEphemeral5mInputTokens: types.Int64Value(0),
+			CacheReadInputTokens:                types.Int64Value(0),
+			OutputTokens:                        types.Int64Value(800),
+			WebSearchRequests:                   types.Int64Value(0),
+			ApiKeyId:                            types.StringNull(),
+			WorkspaceId:                         types.StringValue("wrkspc_test"),
+			Model:                               types.StringValue("claude-3-5-haiku-20241022"),
+			ServiceTier:                         types.StringNull(),
+			ContextWindow:                       types.StringNull(),
+		},
+		{
+			StartingAt:                          types.StringValue("2025-01-03T00:00:00Z"),
+			EndingAt:                            types.StringValue("2025-01-04T00:00:00Z"),
+			UncachedInputTokens:                 types.Int64Value(5),
+			CacheCreationEphemeral1hInputTokens: types.Int64Value(0),
+			CacheCreationEphemeral5mInputTokens: types.Int64Value(0),
+			CacheReadInputTokens:                types.Int64Value(0),
+			OutputTokens:                        types.Int64Value(1),
+			WebSearchRequests:                   types.Int64Value(0),
+			ApiKeyId:                            types.StringNull(),
+			WorkspaceId:                         types.StringNull(),
+			Model:                               types.StringValue("claude-sonnet-4-5-20250929"),
+			ServiceTier:                         types.StringNull(),
+			ContextWindow:                       types.StringNull(),
+		},
+	}
+	if !reflect.DeepEqual(m.Results, want) {
+		t.Errorf("got results %+v, want %+v", m.Results, want)
+	}
+}
@@ -89,7 +89,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 
 	if httpResp.StatusCode() != 200 {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got status code: %d", httpResp.StatusCode()))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 
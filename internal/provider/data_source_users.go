@@ -99,7 +99,7 @@ func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		}
 
 		if httpResp.StatusCode() != 200 {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got status code: %d", httpResp.StatusCode()))
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
 			return
 		}
 
@@ -62,7 +62,7 @@ func (d *WorkspaceMemberDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	if httpResp.StatusCode() != 200 {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got status code: %d", httpResp.StatusCode()))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 
@@ -14,7 +14,7 @@ import (
 
 func TestAccWorkspaceMemberDataSource(t *testing.T) {
 	rn := "data.anthropic_workspace_member.test"
-	workspaceName := acctest.RandomWithPrefix("tf-workspace")
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -98,7 +98,7 @@ func (d *WorkspaceMembersDataSource) Read(ctx context.Context, req datasource.Re
 		}
 
 		if httpResp.StatusCode() != 200 {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got status code: %d", httpResp.StatusCode()))
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
 			return
 		}
 
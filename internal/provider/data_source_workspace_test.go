@@ -13,7 +13,7 @@ import (
 
 func TestAccWorkspaceDataSource(t *testing.T) {
 	rn := "data.anthropic_workspace.test"
-	workspaceName := acctest.RandomWithPrefix("tf-workspace")
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -13,7 +13,7 @@ import (
 
 func TestAccWorkspacesDataSource(t *testing.T) {
 	rn := "data.anthropic_workspaces.test"
-	workspaceName := acctest.RandomWithPrefix("tf-workspace")
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
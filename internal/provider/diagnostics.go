@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+// NewApiErrorDiagnostic returns a diagnostic describing an unsuccessful API
+// response. The action is a short phrase such as "Unable to read workspace".
+func NewApiErrorDiagnostic(action string, httpResp *http.Response, body []byte) diag.Diagnostic {
+	return NewApiErrorDiagnosticWithAttributes(action, httpResp, body, nil)
+}
+
+// NewApiErrorDiagnosticWithAttributes is like NewApiErrorDiagnostic, but
+// attaches the diagnostic to an attribute when the API error message refers to
+// one of the request fields in attributes.
+func NewApiErrorDiagnosticWithAttributes(action string, httpResp *http.Response, body []byte, attributes map[string]path.Path) diag.Diagnostic {
+	return newApiErrorDiagnostic(action, apiclient.NewAPIError(httpResp, body), false, attributes)
+}
+
+// NewInferenceApiErrorDiagnostic is like NewApiErrorDiagnostic, for responses
+// from the standard API called with the inference API key.
+func NewInferenceApiErrorDiagnostic(action string, httpResp *http.Response, body []byte) diag.Diagnostic {
+	return newApiErrorDiagnostic(action, apiclient.NewAPIError(httpResp, body), true, nil)
+}
+
+// NewClientErrorDiagnostic returns a diagnostic for an error returned while
+// calling the API. API errors are described the same way as
+// NewApiErrorDiagnostic; any other error is reported as is.
+func NewClientErrorDiagnostic(action string, err error) diag.Diagnostic {
+	var apiErr *apiclient.APIError
+	if errors.As(err, &apiErr) {
+		return newApiErrorDiagnostic(action, apiErr, false, nil)
+	}
+
+	return diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("%s, got error: %s", action, err))
+}
+
+func newApiErrorDiagnostic(action string, apiErr *apiclient.APIError, inference bool, attributes map[string]path.Path) diag.Diagnostic {
+	summary, hint := describeApiError(apiErr, inference)
+
+	var detail strings.Builder
+	if apiErr.Message != "" {
+		fmt.Fprintf(&detail, "%s: %s", action, apiErr.Message)
+	} else {
+		fmt.Fprintf(&detail, "%s, got status code %d: %s", action, apiErr.StatusCode, string(apiErr.Body))
+	}
+
+	if hint != "" {
+		fmt.Fprintf(&detail, "\n\n%s", hint)
+	}
+
+	if apiErr.RequestId != "" {
+		fmt.Fprintf(&detail, "\n\nRequest ID: %s", apiErr.RequestId)
+	}
+
+	if p, ok := apiErrorAttribute(apiErr, attributes); ok {
+		return diag.NewAttributeErrorDiagnostic(p, summary, detail.String())
+	}
+
+	return diag.NewErrorDiagnostic(summary, detail.String())
+}
+
+// describeApiError returns the diagnostic summary and an actionable hint for
+// an API error. Inference reports whether the request was sent to the standard
+// API with the inference API key rather than to the Admin API.
+func describeApiError(apiErr *apiclient.APIError, inference bool) (string, string) {
+	switch {
+	case inference && apiErr.Type == apiclient.ErrorTypeAuthentication:
+		return "Authentication Failed", "Check that the standard API key configured for the provider, through `inference_api_key` or the ANTHROPIC_API_KEY environment variable, is valid."
+	case inference && apiErr.Type == apiclient.ErrorTypePermission:
+		return "Permission Denied", "The standard API requires a standard API key (starting with `sk-ant-api`) that is allowed to use this endpoint. Admin API keys cannot call the standard API."
+	}
+
+	switch apiErr.Type {
+	case apiclient.ErrorTypeInvalidRequest:
+		return "Invalid Request", "The API rejected the request. Check the values in the configuration."
+	case apiclient.ErrorTypeAuthentication:
+		return "Authentication Failed", "Check that the API key configured for the provider, through `api_key`, `api_key_file`, `credential_command` or the ANTHROPIC_ADMIN_API_KEY or ANTHROPIC_API_KEY environment variable, is valid."
+	case apiclient.ErrorTypePermission:
+		return "Permission Denied", "The Admin API requires an Admin API key (starting with `sk-ant-admin`), which can be created by an organization admin in the Anthropic Console. Standard API keys cannot manage organization resources."
+	case apiclient.ErrorTypeNotFound:
+		return "Not Found", "The resource does not exist or is not visible to this API key."
+	case apiclient.ErrorTypeRequestTooLarge:
+		return "Request Too Large", "The request exceeds the maximum allowed size."
+	case apiclient.ErrorTypeRateLimit:
+		return "Rate Limited", "The API key has hit a rate limit. Retry later, or reduce concurrency with `terraform apply -parallelism=n`."
+	case apiclient.ErrorTypeApi:
+		return "API Error", "An unexpected error occurred inside the Anthropic API. Retry later."
+	case apiclient.ErrorTypeOverloaded:
+		return "API Overloaded", "The Anthropic API is temporarily overloaded. Retry later."
+	default:
+		return "Client Error", ""
+	}
+}
+
+// apiErrorAttribute returns the attribute path of the request field that an
+// invalid request error refers to. Validation messages from the API are
+// prefixed with the name of the offending field, e.g. "name: String should
+// have at most 40 characters".
+func apiErrorAttribute(apiErr *apiclient.APIError, attributes map[string]path.Path) (path.Path, bool) {
+	if apiErr.Type != apiclient.ErrorTypeInvalidRequest || len(attributes) == 0 {
+		return path.Empty(), false
+	}
+
+	field, _, ok := strings.Cut(apiErr.Message, ":")
+	if !ok {
+		return path.Empty(), false
+	}
+
+	p, ok := attributes[strings.TrimSpace(field)]
+	return p, ok
+}
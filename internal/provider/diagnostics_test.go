@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func newTestErrorResponse(statusCode int, requestId string) *http.Response {
+	httpResp := &http.Response{
+		StatusCode: statusCode,
+		Header:     make(http.Header),
+	}
+	if requestId != "" {
+		httpResp.Header.Set("request-id", requestId)
+	}
+	return httpResp
+}
+
+func newTestErrorBody(errorType, message string) []byte {
+	return fmt.Appendf(nil, `{"type":"error","error":{"type":%q,"message":%q}}`, errorType, message)
+}
+
+func TestNewApiErrorDiagnostic(t *testing.T) {
+	testCases := []struct {
+		name        string
+		statusCode  int
+		errorType   string
+		wantSummary string
+	}{
+		{"invalid request", http.StatusBadRequest, apiclient.ErrorTypeInvalidRequest, "Invalid Request"},
+		{"authentication", http.StatusUnauthorized, apiclient.ErrorTypeAuthentication, "Authentication Failed"},
+		{"permission", http.StatusForbidden, apiclient.ErrorTypePermission, "Permission Denied"},
+		{"not found", http.StatusNotFound, apiclient.ErrorTypeNotFound, "Not Found"},
+		{"request too large", http.StatusRequestEntityTooLarge, apiclient.ErrorTypeRequestTooLarge, "Request Too Large"},
+		{"rate limit", http.StatusTooManyRequests, apiclient.ErrorTypeRateLimit, "Rate Limited"},
+		{"api", http.StatusInternalServerError, apiclient.ErrorTypeApi, "API Error"},
+		{"overloaded", statusOverloaded, apiclient.ErrorTypeOverloaded, "API Overloaded"},
+		{"unknown", http.StatusTeapot, "teapot_error", "Client Error"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewApiErrorDiagnostic("Unable to read workspace", newTestErrorResponse(tc.statusCode, "req_test"), newTestErrorBody(tc.errorType, "Something went wrong"))
+
+			if d.Severity() != diag.SeverityError {
+				t.Errorf("got severity %s, want error", d.Severity())
+			}
+			if d.Summary() != tc.wantSummary {
+				t.Errorf("got summary %q, want %q", d.Summary(), tc.wantSummary)
+			}
+			if !strings.HasPrefix(d.Detail(), "Unable to read workspace: Something went wrong") {
+				t.Errorf("got detail %q, want the action and the API error message", d.Detail())
+			}
+			if !strings.HasSuffix(d.Detail(), "\n\nRequest ID: req_test") {
+				t.Errorf("got detail %q, want the request ID", d.Detail())
+			}
+		})
+	}
+}
+
+func TestNewApiErrorDiagnostic_nonJSON(t *testing.T) {
+	d := NewApiErrorDiagnostic("Unable to read workspace", newTestErrorResponse(http.StatusBadGateway, ""), []byte("<html>Bad Gateway</html>"))
+
+	if d.Summary() != "Client Error" {
+		t.Errorf("got summary %q, want %q", d.Summary(), "Client Error")
+	}
+	if want := "Unable to read workspace, got status code 502: <html>Bad Gateway</html>"; d.Detail() != want {
+		t.Errorf("got detail %q, want %q", d.Detail(), want)
+	}
+}
+
+func TestNewApiErrorDiagnostic_missingRequestId(t *testing.T) {
+	d := NewApiErrorDiagnostic("Unable to read workspace", newTestErrorResponse(http.StatusNotFound, ""), newTestErrorBody(apiclient.ErrorTypeNotFound, "Not found"))
+
+	if strings.Contains(d.Detail(), "Request ID") {
+		t.Errorf("got detail %q, want no request ID", d.Detail())
+	}
+}
+
+func TestNewApiErrorDiagnostic_permissionHint(t *testing.T) {
+	body := newTestErrorBody(apiclient.ErrorTypePermission, "Permission denied")
+
+	admin := NewApiErrorDiagnostic("Unable to read workspace", newTestErrorResponse(http.StatusForbidden, ""), body)
+	if !strings.Contains(admin.Detail(), "Admin API key") {
+		t.Errorf("got detail %q, want a hint about Admin API keys", admin.Detail())
+	}
+
+	inference := NewInferenceApiErrorDiagnostic("Unable to read", newTestErrorResponse(http.StatusForbidden, ""), body)
+	if !strings.Contains(inference.Detail(), "standard API key") || strings.Contains(inference.Detail(), "sk-ant-admin") {
+		t.Errorf("got detail %q, want a hint about standard API keys", inference.Detail())
+	}
+}
+
+func TestNewApiErrorDiagnosticWithAttributes(t *testing.T) {
+	attributes := map[string]path.Path{
+		"name": path.Root("name"),
+	}
+
+	testCases := []struct {
+		name          string
+		body          []byte
+		wantPath      path.Path
+		wantAttribute bool
+	}{
+		{"field", newTestErrorBody(apiclient.ErrorTypeInvalidRequest, "name: String should have at most 40 characters"), path.Root("name"), true},
+		{"unknown field", newTestErrorBody(apiclient.ErrorTypeInvalidRequest, "data_residency: Invalid value"), path.Empty(), false},
+		{"not invalid request", newTestErrorBody(apiclient.ErrorTypeNotFound, "name: Not found"), path.Empty(), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewApiErrorDiagnosticWithAttributes("Unable to create workspace", newTestErrorResponse(http.StatusBadRequest, ""), tc.body, attributes)
+
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if ok != tc.wantAttribute {
+				t.Fatalf("got attribute diagnostic %t, want %t", ok, tc.wantAttribute)
+			}
+			if ok && !withPath.Path().Equal(tc.wantPath) {
+				t.Errorf("got path %s, want %s", withPath.Path(), tc.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	logEmailRegexp  = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	logApiKeyRegexp = regexp.MustCompile(`sk-ant-[A-Za-z0-9_\-]+`)
+)
+
+// logRedactedHeaders are request and response headers whose values are never
+// logged.
+var logRedactedHeaders = []string{
+	"x-api-key",
+	"authorization",
+}
+
+// loggingTransport logs every HTTP request and response. Method, path, status,
+// latency, retry attempt and request ID are logged at DEBUG; headers and
+// bodies at TRACE. API keys and email addresses are masked.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func newLoggingTransport(next http.RoundTripper) http.RoundTripper {
+	return &loggingTransport{
+		next: next,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.MaskLogRegexes(req.Context(), logEmailRegexp, logApiKeyRegexp)
+
+	fields := map[string]any{
+		"http_method": req.Method,
+		"http_path":   req.URL.Path,
+	}
+	if req.URL.RawQuery != "" {
+		query, err := url.QueryUnescape(req.URL.RawQuery)
+		if err != nil {
+			query = req.URL.RawQuery
+		}
+		fields["http_query"] = query
+	}
+	if attempt := retryAttemptFromContext(req.Context()); attempt > 0 {
+		fields["http_attempt"] = attempt
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		clone := req.Clone(req.Context())
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+		req = clone
+
+		tflog.Trace(ctx, "Sending HTTP request", withLogFields(fields, map[string]any{
+			"http_request_headers": redactHeaders(req.Header),
+			"http_request_body":    string(body),
+		}))
+	} else {
+		tflog.Trace(ctx, "Sending HTTP request", withLogFields(fields, map[string]any{
+			"http_request_headers": redactHeaders(req.Header),
+		}))
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		tflog.Debug(ctx, "HTTP request failed", withLogFields(fields, map[string]any{
+			"error": err.Error(),
+		}))
+		return resp, err
+	}
+
+	fields["http_status_code"] = resp.StatusCode
+	if requestId := resp.Header.Get("request-id"); requestId != "" {
+		fields["http_request_id"] = requestId
+	}
+
+	tflog.Debug(ctx, "Received HTTP response", fields)
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	tflog.Trace(ctx, "Received HTTP response body", withLogFields(fields, map[string]any{
+		"http_response_headers": redactHeaders(resp.Header),
+		"http_response_body":    string(body),
+	}))
+
+	return resp, nil
+}
+
+func withLogFields(fields map[string]any, extra map[string]any) map[string]any {
+	out := maps.Clone(fields)
+	maps.Copy(out, extra)
+	return out
+}
+
+// redactHeaders formats headers for logging, one per line, masking the values
+// of sensitive headers.
+func redactHeaders(h http.Header) string {
+	var b strings.Builder
+	for _, k := range slices.Sorted(maps.Keys(h)) {
+		v := strings.Join(h[k], ", ")
+		if slices.Contains(logRedactedHeaders, strings.ToLower(k)) {
+			v = "***"
+		}
+		b.WriteString(k + ": " + v + "\n")
+	}
+	return b.String()
+}
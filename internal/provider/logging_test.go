@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("request-id", "req_test")
+		_, _ = w.Write([]byte(`{"id":"user_test","email":"jane@example.com"}`))
+	}))
+	defer srv.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	p, _ := newRetryPolicy(nil)
+	client := &http.Client{Transport: p.Transport(newLoggingTransport(http.DefaultTransport))}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/v1/organizations/users?email=jane%40example.com", strings.NewReader(`{"email":"jane@example.com"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	req.Header.Set("x-api-key", "sk-ant-admin01-secret")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "jane@example.com") {
+		t.Errorf("response body was not passed through, got %q", body)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode log output: %s", err)
+	}
+
+	var found bool
+	for _, entry := range entries {
+		if entry["@message"] == "Received HTTP response" {
+			found = true
+			if entry["http_status_code"] != float64(http.StatusOK) || entry["http_request_id"] != "req_test" || entry["http_attempt"] != float64(1) {
+				t.Errorf("unexpected response log entry: %v", entry)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("no response log entry found in %v", entries)
+	}
+
+	for _, secret := range []string{"jane@example.com", "jane%40example.com", "sk-ant-admin01-secret"} {
+		if strings.Contains(output.String(), secret) {
+			t.Errorf("log output contains %q", secret)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+type ApiKeyModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Status              types.String `tfsdk:"status"`
+	WorkspaceId         types.String `tfsdk:"workspace_id"`
+	CreatedBy           types.String `tfsdk:"created_by"`
+	PartialKeyHint      types.String `tfsdk:"partial_key_hint"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	DeactivateOnDestroy types.Bool   `tfsdk:"deactivate_on_destroy"`
+}
+
+func (m *ApiKeyModel) Fill(data apiclient.ApiKey) error {
+	m.Id = types.StringValue(data.Id)
+	m.Name = types.StringValue(data.Name)
+	m.Status = types.StringValue(data.Status)
+	m.WorkspaceId = types.StringPointerValue(data.WorkspaceId)
+	m.CreatedBy = types.StringValue(data.CreatedBy.Id)
+	m.PartialKeyHint = types.StringPointerValue(data.PartialKeyHint)
+	m.CreatedAt = types.StringValue(data.CreatedAt)
+
+	return nil
+}
@@ -24,3 +24,15 @@ func (m *OrganizationInviteModel) Fill(data apiclient.Invite) error {
 
 	return nil
 }
+
+// OrganizationInviteIdentityModel describes the identity of an organization
+// invite resource.
+type OrganizationInviteIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func (m OrganizationInviteModel) Identity() OrganizationInviteIdentityModel {
+	return OrganizationInviteIdentityModel{
+		Id: m.Id,
+	}
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+type OrganizationUserModel struct {
+	Id      types.String `tfsdk:"id"`
+	UserId  types.String `tfsdk:"user_id"`
+	Email   types.String `tfsdk:"email"`
+	Name    types.String `tfsdk:"name"`
+	Role    types.String `tfsdk:"role"`
+	AddedAt types.String `tfsdk:"added_at"`
+}
+
+func (m *OrganizationUserModel) Fill(data apiclient.User) error {
+	m.Id = types.StringValue(data.Id)
+	m.UserId = types.StringValue(data.Id)
+	// The configured email is only a lookup key, so keep it even if the
+	// user's email has since changed upstream.
+	if m.Email.IsNull() || m.Email.IsUnknown() {
+		m.Email = types.StringValue(data.Email)
+	}
+	m.Name = types.StringValue(data.Name)
+	m.Role = types.StringValue(data.Role)
+	m.AddedAt = types.StringValue(data.AddedAt)
+
+	return nil
+}
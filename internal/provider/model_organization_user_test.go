@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func TestOrganizationUserModel_Fill_email(t *testing.T) {
+	testCases := []struct {
+		name  string
+		email types.String
+		want  string
+	}{
+		{"unknown", types.StringUnknown(), "alice@example.com"},
+		{"null", types.StringNull(), "alice@example.com"},
+		{"same", types.StringValue("alice@example.com"), "alice@example.com"},
+		{"mixed case", types.StringValue("Alice@Example.com"), "Alice@Example.com"},
+		{"different", types.StringValue("bob@example.com"), "bob@example.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := OrganizationUserModel{Email: tc.email}
+			if err := m.Fill(apiclient.User{Id: "user_test", Email: "alice@example.com"}); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := m.Email.ValueString(); got != tc.want {
+				t.Errorf("got email %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
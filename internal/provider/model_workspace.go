@@ -22,3 +22,14 @@ func (m *WorkspaceModel) Fill(w apiclient.Workspace) error {
 
 	return nil
 }
+
+// WorkspaceIdentityModel describes the identity of a workspace resource.
+type WorkspaceIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func (m WorkspaceModel) Identity() WorkspaceIdentityModel {
+	return WorkspaceIdentityModel{
+		Id: m.Id,
+	}
+}
@@ -18,3 +18,17 @@ func (m *WorkspaceMemberModel) Fill(data apiclient.WorkspaceMember) error {
 
 	return nil
 }
+
+// WorkspaceMemberIdentityModel describes the identity of a workspace member
+// resource.
+type WorkspaceMemberIdentityModel struct {
+	WorkspaceId types.String `tfsdk:"workspace_id"`
+	UserId      types.String `tfsdk:"user_id"`
+}
+
+func (m WorkspaceMemberModel) Identity() WorkspaceMemberIdentityModel {
+	return WorkspaceMemberIdentityModel{
+		WorkspaceId: m.WorkspaceId,
+		UserId:      m.UserId,
+	}
+}
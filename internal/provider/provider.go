@@ -2,15 +2,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
+	"time"
 
-	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
 )
@@ -25,12 +31,49 @@ type AnthropicProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// wrapTransport wraps the transport of the API clients. The acceptance
+	// tests use it to record and replay the requests made by the provider.
+	wrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 // AnthropicProviderModel describes the provider data model.
 type AnthropicProviderModel struct {
-	BaseUrl types.String `tfsdk:"base_url"`
-	ApiKey  types.String `tfsdk:"api_key"`
+	BaseUrl                types.String        `tfsdk:"base_url"`
+	ApiKey                 types.String        `tfsdk:"api_key"`
+	ApiKeyFile             types.String        `tfsdk:"api_key_file"`
+	CredentialCommand      []types.String      `tfsdk:"credential_command"`
+	InferenceApiKey        types.String        `tfsdk:"inference_api_key"`
+	ApiVersion             types.String        `tfsdk:"api_version"`
+	BetaFeatures           []types.String      `tfsdk:"beta_features"`
+	ExpectedOrganizationId types.String        `tfsdk:"expected_organization_id"`
+	MaxConcurrentRequests  types.Int64         `tfsdk:"max_concurrent_requests"`
+	RequestsPerMinute      types.Int64         `tfsdk:"requests_per_minute"`
+	CaCertPem              types.String        `tfsdk:"ca_cert_pem"`
+	CaCertFile             types.String        `tfsdk:"ca_cert_file"`
+	ClientCertPem          types.String        `tfsdk:"client_cert_pem"`
+	ClientKeyPem           types.String        `tfsdk:"client_key_pem"`
+	ProxyUrl               types.String        `tfsdk:"proxy_url"`
+	RequestTimeout         types.String        `tfsdk:"request_timeout"`
+	ReadOnly               types.Bool          `tfsdk:"read_only"`
+	AuditLogPath           types.String        `tfsdk:"audit_log_path"`
+	AuditLogRedactPii      types.Bool          `tfsdk:"audit_log_redact_pii"`
+	Retry                  *ProviderRetryModel `tfsdk:"retry"`
+}
+
+// ProviderData is passed to resources and data sources when they are
+// configured.
+type ProviderData struct {
+	// Client calls the Admin API. It is nil when no Admin API key is
+	// configured.
+	Client *apiclient.ClientWithResponses
+
+	// InferenceClient calls the standard API. It is nil when no standard API
+	// key is configured.
+	InferenceClient *apiclient.ClientWithResponses
+
+	// ReadOnly prevents resources from being created, updated or deleted.
+	ReadOnly bool
 }
 
 func (p *AnthropicProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -47,9 +90,137 @@ func (p *AnthropicProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "The Admin API key for authentication. Get this from the [Anthropic console](https://console.anthropic.com/settings/admin-keys). It can be sourced from the `ANTHROPIC_API_KEY` environment variable.",
+				MarkdownDescription: "The Admin API key for authentication. Get this from the [Anthropic console](https://console.anthropic.com/settings/admin-keys). It can be sourced from the `ANTHROPIC_ADMIN_API_KEY` environment variable, or from the `ANTHROPIC_API_KEY` environment variable if that is not set and it holds an Admin API key. Conflicts with `api_key_file` and `credential_command`, which take precedence over the environment variables.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key_file"), path.MatchRoot("credential_command")),
+				},
+			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the Admin API key. Surrounding whitespace is ignored. Conflicts with `api_key` and `credential_command`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("credential_command")),
+				},
+			},
+			"credential_command": schema.ListAttribute{
+				MarkdownDescription: "Command and arguments of a local helper that prints the Admin API key to standard output, e.g. `[\"op\", \"read\", \"op://vault/anthropic/credential\"]`. The command is run directly, not through a shell. Conflicts with `api_key` and `api_key_file`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"inference_api_key": schema.StringAttribute{
+				MarkdownDescription: "A standard API key for the data sources that use the standard Anthropic API rather than the Admin API, such as `anthropic_models`. Get this from the [Anthropic console](https://console.anthropic.com/settings/keys). It can be sourced from the `ANTHROPIC_API_KEY` environment variable, unless that holds an Admin API key.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"api_version": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Version of the Anthropic API, sent as the `anthropic-version` header. Defaults to `%s`. It can be sourced from the `ANTHROPIC_API_VERSION` environment variable.", apiclient.DefaultApiVersion),
+				Optional:            true,
+			},
+			"beta_features": schema.ListAttribute{
+				MarkdownDescription: "Beta features to opt into for every request, sent as the `anthropic-beta` header, e.g. `[\"files-api-2025-04-14\"]`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"expected_organization_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Organization that the API key is expected to belong to. When set, the provider verifies the API key's Organization before doing anything else and fails if it does not match. It can be sourced from the `ANTHROPIC_EXPECTED_ORGANIZATION_ID` environment variable.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests in flight at the same time, shared by all resources and data sources. Defaults to no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests per minute, shared by all resources and data sources. Defaults to no limit. Regardless of this setting, the provider slows down when the `anthropic-ratelimit-*` response headers report that the rate limit is about to be exhausted.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificates of additional certificate authorities to trust, such as the CA of an inspecting proxy or an internal gateway. They are trusted in addition to the system trust store. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded certificates of additional certificate authorities to trust. Conflicts with `ca_cert_pem`.",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS. Requires `client_key_pem`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate for mutual TLS. Requires `client_cert_pem`.",
 				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy to send requests through, e.g. `http://proxy.example.com:3128`. Defaults to the `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time for each attempt of a request, as a duration such as `30s`. Timed out requests are retried according to the `retry` block. Defaults to no timeout.",
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the provider refuses to make any changes: plans that would create, update or delete a resource fail, and any API request other than `GET` is rejected before it is sent. Useful for drift detection with a production key. Defaults to `false`.",
+				Optional:            true,
+			},
+			"audit_log_path": schema.StringAttribute{
+				MarkdownDescription: "Path of a file to which a JSON line is appended for every mutating (`POST` or `DELETE`) API request. Each line records the timestamp, the Terraform resource type and operation, the IDs of the objects acted on or created, the request body, the response status code and the request ID. The file is created if it does not exist.",
+				Optional:            true,
+			},
+			"audit_log_redact_pii": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, email addresses are masked in the request bodies recorded in the audit log. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				MarkdownDescription: "Retry policy for failed API requests. Requests that are not idempotent, such as creating a resource, are only retried when the API did not process them: when the connection could not be established, or on a `429` or `529` response. The `retry-after` header of `429` and `529` responses is always honored.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Maximum number of attempts for each request, including the first one. Defaults to `%d`. Set to `1` to disable retries.", defaultRetryMaxAttempts),
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Minimum time to wait before retrying, as a duration such as `500ms` or `2s`. The wait doubles after each attempt up to `max_backoff`. Defaults to `%s`.", defaultRetryMinBackoff),
+						Optional:            true,
+					},
+					"max_backoff": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Maximum time to wait before retrying, as a duration such as `30s`. Does not limit waits requested by the `retry-after` header. Defaults to `%s`.", defaultRetryMaxBackoff),
+						Optional:            true,
+					},
+					"retry_on_status": schema.ListAttribute{
+						MarkdownDescription: "HTTP status codes that are retried. Defaults to `429`, `500`, `502`, `503`, `504` and `529`.",
+						ElementType:         types.Int64Type,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(
+								int64validator.Between(400, 599),
+							),
+						},
+					},
+				},
 			},
 		},
 	}
@@ -72,11 +243,25 @@ func (p *AnthropicProvider) Configure(ctx context.Context, req provider.Configur
 		baseUrl = "https://api.anthropic.com"
 	}
 
-	var apiKey string
-	if !data.ApiKey.IsNull() {
-		apiKey = data.ApiKey.ValueString()
-	} else if v := os.Getenv("ANTHROPIC_API_KEY"); v != "" {
-		apiKey = v
+	var apiVersion string
+	if !data.ApiVersion.IsNull() {
+		apiVersion = data.ApiVersion.ValueString()
+	} else if v := os.Getenv("ANTHROPIC_API_VERSION"); v != "" {
+		apiVersion = v
+	} else {
+		apiVersion = apiclient.DefaultApiVersion
+	}
+
+	betaFeatures := make([]string, 0, len(data.BetaFeatures))
+	for _, v := range data.BetaFeatures {
+		betaFeatures = append(betaFeatures, v.ValueString())
+	}
+
+	var expectedOrganizationId string
+	if !data.ExpectedOrganizationId.IsNull() {
+		expectedOrganizationId = data.ExpectedOrganizationId.ValueString()
+	} else if v := os.Getenv("ANTHROPIC_EXPECTED_ORGANIZATION_ID"); v != "" {
+		expectedOrganizationId = v
 	}
 
 	if baseUrl == "" {
@@ -84,37 +269,170 @@ func (p *AnthropicProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	if apiKey == "" {
-		resp.Diagnostics.AddError("api_key is required", "api_key is required")
+	apiKey, diags := resolveApiKey(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inferenceApiKey := resolveInferenceApiKey(data)
+
+	if apiKey == "" && inferenceApiKey == "" {
+		resp.Diagnostics.AddError(
+			"api_key is required",
+			"Set one of the api_key, api_key_file or credential_command attributes, or the ANTHROPIC_ADMIN_API_KEY or ANTHROPIC_API_KEY environment variable.",
+		)
+		return
+	}
+
+	retryPolicy, diags := newRetryPolicy(data.Retry)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpTransport, diags := newHTTPTransport(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	retryClient := retryablehttp.NewClient()
-	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
-	retryClient.Logger = nil
-	retryClient.RetryMax = 10
+	var requestTimeout time.Duration
+	if !data.RequestTimeout.IsNull() {
+		d, err := time.ParseDuration(data.RequestTimeout.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Duration",
+				fmt.Sprintf("Expected a positive duration such as \"30s\", got %q.", data.RequestTimeout.ValueString()),
+			)
+			return
+		}
+		requestTimeout = d
+	}
 
-	client, err := apiclient.NewClientWithResponses(
-		baseUrl,
-		apiclient.WithHTTPClient(retryClient.StandardClient()),
-		apiclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("anthropic-version", "2023-06-01")
-			req.Header.Set("x-api-key", apiKey)
-			return nil
-		}),
+	limiter := newRateLimiter(
+		int(data.MaxConcurrentRequests.ValueInt64()),
+		int(data.RequestsPerMinute.ValueInt64()),
 	)
+
+	// Every attempt made by the retry policy passes through the limiter,
+	// which is shared by all resources and data sources through the client.
+	var transport http.RoundTripper = httpTransport
+
+	if data.ReadOnly.ValueBool() {
+		transport = newReadOnlyTransport(transport)
+	}
+	transport = newTimeoutTransport(requestTimeout, transport)
+	transport = newLoggingTransport(transport)
+	transport = limiter.Transport(transport)
+	transport = retryPolicy.Transport(transport)
+
+	// The audit log records each request once, with the outcome of its last
+	// attempt.
+	if !data.AuditLogPath.IsNull() {
+		auditLog, err := newAuditLog(data.AuditLogPath.ValueString(), data.AuditLogRedactPii.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("audit_log_path"),
+				"Invalid Audit Log Path",
+				fmt.Sprintf("Unable to open audit log: %s", err),
+			)
+			return
+		}
+		transport = auditLog.Transport(transport)
+	}
+
+	// Requests replayed by the acceptance tests are answered here, without
+	// being retried or rate limited.
+	if p.wrapTransport != nil {
+		transport = p.wrapTransport(transport)
+	}
+
+	httpClient := &http.Client{
+		Transport: transport,
+	}
+
+	newClient := func(apiKey string) (*apiclient.ClientWithResponses, error) {
+		if apiKey == "" {
+			return nil, nil
+		}
+
+		return apiclient.NewClientWithResponses(
+			baseUrl,
+			apiclient.WithHTTPClient(httpClient),
+			apiclient.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("anthropic-version", apiVersion)
+				req.Header.Set("x-api-key", apiKey)
+				apiclient.AddBetas(req.Header, betaFeatures...)
+				return nil
+			}),
+		)
+	}
+
+	client, err := newClient(apiKey)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create API client", err.Error())
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	inferenceClient, err := newClient(inferenceApiKey)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create API client", err.Error())
+		return
+	}
+
+	if expectedOrganizationId != "" {
+		if client == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expected_organization_id"),
+				"Admin API Key Required",
+				"Verifying the Organization requires an Admin API key. "+adminApiKeyRequiredDetail,
+			)
+			return
+		}
+
+		httpResp, err := client.GetOrganizationWithResponse(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to verify the API key's organization, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to verify the API key's organization", httpResp.HTTPResponse, httpResp.Body))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to verify the API key's organization, got empty response body")
+			return
+		}
+
+		if httpResp.JSON200.Id != expectedOrganizationId {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expected_organization_id"),
+				"Unexpected Organization",
+				fmt.Sprintf("The configured API key belongs to organization %q (%s), but organization %s was expected. Check that the correct API key is set in the provider configuration or environment.", httpResp.JSON200.Name, httpResp.JSON200.Id, expectedOrganizationId),
+			)
+			return
+		}
+	}
+
+	providerData := &ProviderData{
+		Client:          client,
+		InferenceClient: inferenceClient,
+		ReadOnly:        data.ReadOnly.ValueBool(),
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *AnthropicProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewApiKeyResource,
 		NewOrganizationInviteResource,
+		NewOrganizationUserResource,
 		NewWorkspaceMemberResource,
 		NewWorkspaceResource,
 	}
@@ -122,7 +440,14 @@ func (p *AnthropicProvider) Resources(ctx context.Context) []func() resource.Res
 
 func (p *AnthropicProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewApiKeysDataSource,
+		NewClaudeCodeUsageDataSource,
+		NewCostReportDataSource,
+		NewModelDataSource,
+		NewModelsDataSource,
+		NewOrganizationDataSource,
 		NewOrganizationInvitesDataSource,
+		NewUsageReportDataSource,
 		NewUserDataSource,
 		NewUsersDataSource,
 		NewWorkspaceDataSource,
@@ -1,14 +1,78 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
-// reattach.
+// reattach. Its requests are recorded or replayed when cassettes are in use.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"anthropic": providerserver.NewProtocol6WithError(New("test")()),
+	"anthropic": providerserver.NewProtocol6WithError(&AnthropicProvider{
+		version:       "test",
+		wrapTransport: acctest.Transport,
+	}),
+}
+
+// TestMain runs the test sweepers when the -sweep flag is set, and the tests
+// otherwise.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func TestAccProvider_expectedOrganizationId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderExpectedOrganizationIdConfig("00000000-0000-0000-0000-000000000000"),
+				ExpectError: regexp.MustCompile("Unexpected Organization"),
+			},
+		},
+	})
+}
+
+func testAccProviderExpectedOrganizationIdConfig(organizationId string) string {
+	return fmt.Sprintf(`
+provider "anthropic" {
+	expected_organization_id = %[1]q
+}
+
+data "anthropic_organization" "test" {
+}
+`, organizationId)
+}
+
+func TestAccProvider_readOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderReadOnlyConfig(acctest.RandomWithPrefix(t, "tf-workspace")),
+				ExpectError: regexp.MustCompile("Read-Only Mode"),
+			},
+		},
+	})
+}
+
+func testAccProviderReadOnlyConfig(workspaceName string) string {
+	return fmt.Sprintf(`
+provider "anthropic" {
+	read_only = true
+}
+
+resource "anthropic_workspace" "test" {
+	name = %[1]q
+}
+`, workspaceName)
 }
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitHeaderPrefixes are the limits reported by the anthropic-ratelimit-*
+// response headers.
+var rateLimitHeaderPrefixes = []string{
+	"anthropic-ratelimit-requests",
+	"anthropic-ratelimit-tokens",
+	"anthropic-ratelimit-input-tokens",
+	"anthropic-ratelimit-output-tokens",
+}
+
+// rateLimiterLowBudget is the fraction of the request limit below which
+// requests are spread evenly over the time left until the limit resets.
+const rateLimiterLowBudget = 0.25
+
+// rateLimiter limits the number of concurrent requests and the request rate
+// of every client that shares it. It also slows down when the API reports
+// that the rate limit budget is about to run out.
+type rateLimiter struct {
+	// sem holds a token for each request in flight, or is nil when the number
+	// of concurrent requests is unlimited.
+	sem chan struct{}
+
+	// interval is the minimum time between the start of two requests.
+	interval time.Duration
+
+	mu sync.Mutex
+	// next is the earliest time the next request may start.
+	next time.Time
+	// pausedUntil is set when a limit has been exhausted.
+	pausedUntil time.Time
+	// adaptiveInterval spaces requests out until adaptiveUntil when the
+	// remaining request budget is low.
+	adaptiveInterval time.Duration
+	adaptiveUntil    time.Time
+}
+
+// newRateLimiter returns a limiter. A zero maxConcurrent or requestsPerMinute
+// leaves that dimension unlimited.
+func newRateLimiter(maxConcurrent, requestsPerMinute int) *rateLimiter {
+	l := &rateLimiter{}
+	if maxConcurrent > 0 {
+		l.sem = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerMinute > 0 {
+		l.interval = time.Minute / time.Duration(requestsPerMinute)
+	}
+	return l
+}
+
+// Wait blocks until a request may be sent. The returned function must be
+// called once the request has completed.
+func (l *rateLimiter) Wait(ctx context.Context) (func(), error) {
+	release := func() {}
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+			release = func() { <-l.sem }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	start := now
+	if l.next.After(start) {
+		start = l.next
+	}
+	if l.pausedUntil.After(start) {
+		start = l.pausedUntil
+	}
+	interval := l.interval
+	if start.Before(l.adaptiveUntil) && l.adaptiveInterval > interval {
+		interval = l.adaptiveInterval
+	}
+	l.next = start.Add(interval)
+	l.mu.Unlock()
+
+	if d := start.Sub(now); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
+
+// Observe adjusts the limiter to the rate limit state reported by a response.
+func (l *rateLimiter) Observe(resp *http.Response) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == statusOverloaded {
+		if d, ok := parseRetryAfter(resp.Header.Get("retry-after")); ok {
+			if until := now.Add(d); until.After(l.pausedUntil) {
+				l.pausedUntil = until
+			}
+		}
+	}
+
+	for _, prefix := range rateLimitHeaderPrefixes {
+		remaining, err := strconv.ParseInt(resp.Header.Get(prefix+"-remaining"), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		reset, err := time.Parse(time.RFC3339, resp.Header.Get(prefix+"-reset"))
+		if err != nil || !reset.After(now) {
+			continue
+		}
+
+		if remaining <= 0 {
+			if reset.After(l.pausedUntil) {
+				l.pausedUntil = reset
+			}
+			continue
+		}
+
+		// Only the request limit is counted in requests; token limits are
+		// only honored once they are exhausted.
+		if prefix != "anthropic-ratelimit-requests" {
+			continue
+		}
+
+		limit, err := strconv.ParseInt(resp.Header.Get(prefix+"-limit"), 10, 64)
+		if err == nil && float64(remaining) > float64(limit)*rateLimiterLowBudget {
+			l.adaptiveUntil = time.Time{}
+			continue
+		}
+
+		l.adaptiveInterval = reset.Sub(now) / time.Duration(remaining)
+		l.adaptiveUntil = reset
+	}
+}
+
+// Transport returns a transport that sends requests through next once the
+// limiter allows them.
+func (l *rateLimiter) Transport(next http.RoundTripper) http.RoundTripper {
+	return &rateLimitTransport{
+		limiter: l,
+		next:    next,
+	}
+}
+
+type rateLimitTransport struct {
+	limiter *rateLimiter
+	next    http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.limiter.Wait(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.limiter.Observe(resp)
+	return resp, nil
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_maxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newRateLimiter(2, 0).Transport(http.DefaultTransport)}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			resp, err := client.Get(srv.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			resp.Body.Close()
+		})
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got != 2 {
+		t.Errorf("got %d requests in flight, want 2", got)
+	}
+}
+
+func TestRateLimiter_requestsPerMinute(t *testing.T) {
+	l := newRateLimiter(0, 1200)
+
+	start := time.Now()
+	for range 3 {
+		release, err := l.Wait(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		release()
+	}
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests at 1200 requests per minute took %s, want at least 100ms", elapsed)
+	}
+}
+
+func TestRateLimiter_Observe(t *testing.T) {
+	reset := time.Now().Add(2 * time.Second).UTC().Format(time.RFC3339)
+
+	testCases := []struct {
+		name   string
+		status int
+		header http.Header
+		paused bool
+		paced  bool
+	}{
+		{
+			name:   "plenty of budget",
+			status: http.StatusOK,
+			header: http.Header{
+				"Anthropic-Ratelimit-Requests-Limit":     []string{"100"},
+				"Anthropic-Ratelimit-Requests-Remaining": []string{"90"},
+				"Anthropic-Ratelimit-Requests-Reset":     []string{reset},
+			},
+		},
+		{
+			name:   "low budget",
+			status: http.StatusOK,
+			header: http.Header{
+				"Anthropic-Ratelimit-Requests-Limit":     []string{"100"},
+				"Anthropic-Ratelimit-Requests-Remaining": []string{"10"},
+				"Anthropic-Ratelimit-Requests-Reset":     []string{reset},
+			},
+			paced: true,
+		},
+		{
+			name:   "exhausted",
+			status: http.StatusOK,
+			header: http.Header{
+				"Anthropic-Ratelimit-Tokens-Remaining": []string{"0"},
+				"Anthropic-Ratelimit-Tokens-Reset":     []string{reset},
+			},
+			paused: true,
+		},
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			header: http.Header{
+				"Retry-After": []string{"2"},
+			},
+			paused: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newRateLimiter(0, 0)
+			l.Observe(&http.Response{StatusCode: tc.status, Header: tc.header})
+
+			if got := l.pausedUntil.After(time.Now()); got != tc.paused {
+				t.Errorf("got paused %t, want %t", got, tc.paused)
+			}
+			if got := l.adaptiveUntil.After(time.Now()) && l.adaptiveInterval > 0; got != tc.paced {
+				t.Errorf("got paced %t, want %t", got, tc.paced)
+			}
+		})
+	}
+}
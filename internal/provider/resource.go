@@ -9,7 +9,8 @@ import (
 )
 
 type baseResource struct {
-	client *apiclient.ClientWithResponses
+	client   *apiclient.ClientWithResponses
+	readOnly bool
 }
 
 func (r *baseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -18,16 +19,51 @@ func (r *baseResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*apiclient.ClientWithResponses)
+	data, ok := req.ProviderData.(*ProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *apiclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	if data.Client == nil {
+		resp.Diagnostics.AddError(
+			"Admin API Key Required",
+			"This resource uses the Admin API, which requires an Admin API key. "+adminApiKeyRequiredDetail,
+		)
+
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ModifyPlan fails any plan that would create, update or delete the resource
+// when the provider is in read-only mode.
+func (r *baseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !r.readOnly {
+		return
+	}
+
+	var action string
+	switch {
+	case req.State.Raw.IsNull():
+		action = "create"
+	case req.Plan.Raw.IsNull():
+		action = "delete"
+	case !req.Plan.Raw.Equal(req.State.Raw):
+		action = "update"
+	default:
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Read-Only Mode",
+		fmt.Sprintf("The provider is configured with read_only = true, so this resource cannot be planned to %s. Remove read_only from the provider configuration to make changes.", action),
+	)
 }
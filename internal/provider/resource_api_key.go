@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func NewApiKeyResource() resource.Resource {
+	return &ApiKeyResource{}
+}
+
+var _ resource.Resource = &ApiKeyResource{}
+var _ resource.ResourceWithImportState = &ApiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &ApiKeyResource{}
+
+type ApiKeyResource struct {
+	baseResource
+}
+
+func (r *ApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *ApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "API key resource. API keys cannot be created through the Admin API, so this resource adopts an existing key by ID and manages its name and status.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the API key to adopt.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the API key. If not set, the existing name is left unchanged.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the API key. Must be one of `active` or `inactive`. If not set, the existing status is left unchanged.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "inactive"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workspace_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Workspace associated with the API key, or null if the API key belongs to the default Workspace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "ID of the actor that created the API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"partial_key_hint": schema.StringAttribute{
+				MarkdownDescription: "Partially redacted hint for the API key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 datetime string indicating when the API key was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deactivate_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to set the API key's status to `inactive` when this resource is destroyed. Defaults to `false`, in which case the API key is only removed from the Terraform state.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_api_key", "create")
+
+	var data ApiKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.GetApiKeyWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "API Key Not Found", fmt.Sprintf("No API key with ID %q exists in the Organization.", data.Id.ValueString()))
+		return
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read API key", httpResp.HTTPResponse, httpResp.Body))
+		return
+	}
+
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to read API key, got empty response body")
+		return
+	}
+
+	apiKey := *httpResp.JSON200
+
+	var body apiclient.UpdateApiKeyJSONRequestBody
+	if !data.Name.IsNull() && !data.Name.IsUnknown() && data.Name.ValueString() != apiKey.Name {
+		body.Name = data.Name.ValueStringPointer()
+	}
+	if !data.Status.IsNull() && !data.Status.IsUnknown() && data.Status.ValueString() != apiKey.Status {
+		body.Status = data.Status.ValueStringPointer()
+	}
+
+	if body.Name != nil || body.Status != nil {
+		httpResp, err := r.client.UpdateApiKeyWithResponse(
+			ctx,
+			apiKey.Id,
+			body,
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API key, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+				"Unable to update API key",
+				httpResp.HTTPResponse,
+				httpResp.Body,
+				map[string]path.Path{
+					"name":   path.Root("name"),
+					"status": path.Root("status"),
+				},
+			))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to update API key, got empty response body")
+			return
+		}
+
+		apiKey = *httpResp.JSON200
+	}
+
+	if err := data.Fill(apiKey); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ApiKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.GetApiKeyWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read API key", httpResp.HTTPResponse, httpResp.Body))
+		return
+	}
+
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to read API key, got empty response body")
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data: %s", err))
+		return
+	}
+
+	// Imported resources have no prior value for this provider-only attribute.
+	if data.DeactivateOnDestroy.IsNull() {
+		data.DeactivateOnDestroy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_api_key", "update")
+
+	var data ApiKeyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.UpdateApiKeyWithResponse(
+		ctx,
+		data.Id.ValueString(),
+		apiclient.UpdateApiKeyJSONRequestBody{
+			Name:   data.Name.ValueStringPointer(),
+			Status: data.Status.ValueStringPointer(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API key, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+			"Unable to update API key",
+			httpResp.HTTPResponse,
+			httpResp.Body,
+			map[string]path.Path{
+				"name":   path.Root("name"),
+				"status": path.Root("status"),
+			},
+		))
+		return
+	}
+
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to update API key, got empty response body")
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_api_key", "delete")
+
+	var data ApiKeyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// API keys cannot be deleted through the Admin API. Unless deactivation is
+	// requested, destroying the resource only removes it from the state.
+	if !data.DeactivateOnDestroy.ValueBool() {
+		return
+	}
+
+	httpResp, err := r.client.UpdateApiKeyWithResponse(
+		ctx,
+		data.Id.ValueString(),
+		apiclient.UpdateApiKeyJSONRequestBody{
+			Status: new("inactive"),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate API key, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to deactivate API key", httpResp.HTTPResponse, httpResp.Body))
+		return
+	}
+}
+
+func (r *ApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
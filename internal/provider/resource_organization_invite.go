@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,6 +22,8 @@ func NewOrganizationInviteResource() resource.Resource {
 
 var _ resource.Resource = &OrganizationInviteResource{}
 var _ resource.ResourceWithImportState = &OrganizationInviteResource{}
+var _ resource.ResourceWithIdentity = &OrganizationInviteResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationInviteResource{}
 
 type OrganizationInviteResource struct {
 	baseResource
@@ -81,7 +84,20 @@ func (r *OrganizationInviteResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+func (r *OrganizationInviteResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the invite.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *OrganizationInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_organization_invite", "create")
+
 	var data OrganizationInviteModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -102,7 +118,15 @@ func (r *OrganizationInviteResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create invite, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+			"Unable to create invite",
+			httpResp.HTTPResponse,
+			httpResp.Body,
+			map[string]path.Path{
+				"email": path.Root("email"),
+				"role":  path.Root("role"),
+			},
+		))
 		return
 	}
 
@@ -117,6 +141,7 @@ func (r *OrganizationInviteResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *OrganizationInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -139,7 +164,7 @@ func (r *OrganizationInviteResource) Read(ctx context.Context, req resource.Read
 	}
 
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read invite, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read invite", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 
@@ -154,6 +179,7 @@ func (r *OrganizationInviteResource) Read(ctx context.Context, req resource.Read
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *OrganizationInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -162,6 +188,8 @@ func (r *OrganizationInviteResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *OrganizationInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_organization_invite", "delete")
+
 	var data OrganizationInviteModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -179,11 +207,11 @@ func (r *OrganizationInviteResource) Delete(ctx context.Context, req resource.De
 	}
 
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete invite, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to delete invite", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 }
 
 func (r *OrganizationInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+// testInviteEmailRegexp matches the email addresses invited by the
+// acceptance tests, e.g. tf-user-1234567890@example.com.
+var testInviteEmailRegexp = regexp.MustCompile(`^tf-.+@example\.com$`)
+
+func init() {
+	resource.AddTestSweepers("anthropic_organization_invite", &resource.Sweeper{
+		Name: "anthropic_organization_invite",
+		// Users who accepted an invite are removed before the invites.
+		Dependencies: []string{
+			"anthropic_organization_user",
+		},
+		F: func(r string) error {
+			ctx := context.Background()
+
+			params := &apiclient.ListInvitesParams{
+				Limit: new(100),
+			}
+
+			// Invites are collected before deleting any, so that the
+			// pagination cursor is not deleted from under the listing.
+			var inviteIds []string
+			for {
+				httpResp, err := acctest.SharedClient.ListInvitesWithResponse(
+					ctx,
+					params,
+				)
+				if err != nil {
+					return fmt.Errorf("Unable to read, got error: %s", err)
+				}
+
+				if httpResp.StatusCode() != http.StatusOK {
+					return fmt.Errorf("Unable to read, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+				}
+
+				// Only pending invites can be deleted.
+				for _, invite := range httpResp.JSON200.Data {
+					if invite.Status == "pending" && testInviteEmailRegexp.MatchString(invite.Email) {
+						inviteIds = append(inviteIds, invite.Id)
+					}
+				}
+
+				if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+					break
+				}
+
+				params.AfterId = httpResp.JSON200.LastId
+			}
+
+			for _, inviteId := range inviteIds {
+				log.Printf("[INFO] Destroying invite %s", inviteId)
+
+				httpResp, err := acctest.SharedClient.DeleteInviteWithResponse(
+					ctx,
+					inviteId,
+				)
+				if err != nil {
+					log.Printf("[ERROR] Unable to delete invite %s: %s", inviteId, err)
+					continue
+				}
+
+				if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNotFound {
+					log.Printf("[ERROR] Unable to delete invite %s, got status code %d: %s", inviteId, httpResp.StatusCode(), string(httpResp.Body))
+					continue
+				}
+
+				log.Printf("[INFO] Deleted invite %s", inviteId)
+			}
+
+			return nil
+		},
+	})
+}
+
+func TestAccOrganizationInviteResource(t *testing.T) {
+	rn := "anthropic_organization_invite.test"
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix(t, "tf-invite"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationInviteResourceConfig(email, "user"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("email"), knownvalue.StringExact(email)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("user")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("status"), knownvalue.StringExact("pending")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("created_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectIdentity(rn, map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState(rn, tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func testAccOrganizationInviteResourceConfig(email string, role string) string {
+	return fmt.Sprintf(`
+resource "anthropic_organization_invite" "test" {
+	email = %[1]q
+	role  = %[2]q
+}
+`, email, role)
+}
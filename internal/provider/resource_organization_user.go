@@ -0,0 +1,385 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func NewOrganizationUserResource() resource.Resource {
+	return &OrganizationUserResource{}
+}
+
+var _ resource.Resource = &OrganizationUserResource{}
+var _ resource.ResourceWithImportState = &OrganizationUserResource{}
+var _ resource.ResourceWithModifyPlan = &OrganizationUserResource{}
+
+type OrganizationUserResource struct {
+	baseResource
+}
+
+func (r *OrganizationUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_user"
+}
+
+func (r *OrganizationUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Organization user resource. Adopts an existing member of the Organization, typically one who has accepted an `anthropic_organization_invite`, and manages their organization role. Destroying this resource removes the user from the Organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the User.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the User to adopt. Exactly one of `user_id` or `email` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("user_id"), path.MatchRoot("email")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email of the User to adopt. Exactly one of `user_id` or `email` must be set. The email is only used to look up the User: changing it replaces the resource only when the new email belongs to a different User.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the User.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Organization role of the User. Must be one of `user`, `developer`, `billing`, `admin`, or `claude_code_user`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "developer", "billing", "admin", "claude_code_user"),
+				},
+			},
+			"added_at": schema.StringAttribute{
+				MarkdownDescription: "RFC 3339 datetime string indicating when the User joined the Organization.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.baseResource.ModifyPlan(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state OrganizationUserModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Email.IsNull() || plan.Email.IsUnknown() || strings.EqualFold(plan.Email.ValueString(), state.Email.ValueString()) {
+		return
+	}
+
+	// The email may have changed upstream for the same user, so only replace
+	// the resource when the configured email belongs to someone else.
+	user, err := r.findUserByEmail(ctx, plan.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(NewClientErrorDiagnostic("Unable to read user", err))
+		return
+	}
+
+	if user != nil && user.Id != state.Id.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("email"))
+	}
+}
+
+func (r *OrganizationUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_organization_user", "create")
+
+	var data OrganizationUserModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *apiclient.User
+	if !data.UserId.IsNull() && !data.UserId.IsUnknown() {
+		httpResp, err := r.client.GetUserWithResponse(ctx, data.UserId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() == http.StatusNotFound {
+			resp.Diagnostics.AddAttributeError(path.Root("user_id"), "User Not Found", fmt.Sprintf("No user with ID %q exists in the Organization. The user must accept their invite before they can be managed.", data.UserId.ValueString()))
+			return
+		}
+
+		if httpResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read user", httpResp.HTTPResponse, httpResp.Body))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to read user, got empty response body")
+			return
+		}
+
+		user = httpResp.JSON200
+	} else {
+		var err error
+		user, err = r.findUserByEmail(ctx, data.Email.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(NewClientErrorDiagnostic("Unable to read user", err))
+			return
+		}
+
+		if user == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email"), "User Not Found", fmt.Sprintf("No user with email %q exists in the Organization. The user must accept their invite before they can be managed.", data.Email.ValueString()))
+			return
+		}
+	}
+
+	if user.Role != data.Role.ValueString() {
+		httpResp, err := r.client.UpdateUserWithResponse(
+			ctx,
+			user.Id,
+			apiclient.UpdateUserJSONRequestBody{
+				Role: data.Role.ValueString(),
+			},
+		)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+			return
+		}
+
+		if httpResp.StatusCode() != http.StatusOK {
+			resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+				"Unable to update user",
+				httpResp.HTTPResponse,
+				httpResp.Body,
+				map[string]path.Path{
+					"role": path.Root("role"),
+				},
+			))
+			return
+		}
+
+		if httpResp.JSON200 == nil {
+			resp.Diagnostics.AddError("Client Error", "Unable to update user, got empty response body")
+			return
+		}
+
+		user = httpResp.JSON200
+	}
+
+	if err := data.Fill(*user); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationUserModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.GetUserWithResponse(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read user", httpResp.HTTPResponse, httpResp.Body))
+		return
+	}
+
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to read user, got empty response body")
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_organization_user", "update")
+
+	var data OrganizationUserModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.UpdateUserWithResponse(
+		ctx,
+		data.Id.ValueString(),
+		apiclient.UpdateUserJSONRequestBody{
+			Role: data.Role.ValueString(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+			"Unable to update user",
+			httpResp.HTTPResponse,
+			httpResp.Body,
+			map[string]path.Path{
+				"role": path.Root("role"),
+			},
+		))
+		return
+	}
+
+	if httpResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to update user, got empty response body")
+		return
+	}
+
+	if err := data.Fill(*httpResp.JSON200); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_organization_user", "delete")
+
+	var data OrganizationUserModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.client.DeleteUserWithResponse(
+		ctx,
+		data.Id.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
+		return
+	}
+
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+
+	if httpResp.StatusCode() != http.StatusOK {
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to delete user", httpResp.HTTPResponse, httpResp.Body))
+		return
+	}
+}
+
+func (r *OrganizationUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The import ID may be either a user ID or an email address.
+	if !strings.Contains(req.ID, "@") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	user, err := r.findUserByEmail(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.Append(NewClientErrorDiagnostic("Unable to read user", err))
+		return
+	}
+
+	if user == nil {
+		resp.Diagnostics.AddError("User Not Found", fmt.Sprintf("No user with email %q exists in the Organization.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), user.Id)...)
+}
+
+// findUserByEmail returns the user with the given email address, or nil if no
+// such user exists in the Organization.
+func (r *OrganizationUserResource) findUserByEmail(ctx context.Context, email string) (*apiclient.User, error) {
+	params := &apiclient.ListUsersParams{
+		Limit: new(100),
+		Email: &email,
+	}
+
+	for {
+		httpResp, err := r.client.ListUsersWithResponse(
+			ctx,
+			params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if httpResp.StatusCode() != http.StatusOK {
+			return nil, apiclient.NewAPIError(httpResp.HTTPResponse, httpResp.Body)
+		}
+
+		if httpResp.JSON200 == nil {
+			return nil, fmt.Errorf("got empty response body")
+		}
+
+		for _, user := range httpResp.JSON200.Data {
+			if strings.EqualFold(user.Email, email) {
+				return &user, nil
+			}
+		}
+
+		if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+			break
+		}
+
+		params.AfterId = httpResp.JSON200.LastId
+	}
+
+	return nil, nil
+}
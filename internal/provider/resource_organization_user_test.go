@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
+)
+
+func init() {
+	resource.AddTestSweepers("anthropic_organization_user", &resource.Sweeper{
+		Name: "anthropic_organization_user",
+		F: func(r string) error {
+			ctx := context.Background()
+
+			params := &apiclient.ListUsersParams{
+				Limit: new(100),
+			}
+
+			// Only users who accepted an invite sent by the acceptance tests
+			// are removed, never the test user.
+			var userIds []string
+			for {
+				httpResp, err := acctest.SharedClient.ListUsersWithResponse(
+					ctx,
+					params,
+				)
+				if err != nil {
+					return fmt.Errorf("Unable to read, got error: %s", err)
+				}
+
+				if httpResp.StatusCode() != http.StatusOK {
+					return fmt.Errorf("Unable to read, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+				}
+
+				for _, user := range httpResp.JSON200.Data {
+					if user.Id != acctest.TestUserId && testInviteEmailRegexp.MatchString(user.Email) {
+						userIds = append(userIds, user.Id)
+					}
+				}
+
+				if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+					break
+				}
+
+				params.AfterId = httpResp.JSON200.LastId
+			}
+
+			for _, userId := range userIds {
+				log.Printf("[INFO] Destroying user %s", userId)
+
+				httpResp, err := acctest.SharedClient.DeleteUserWithResponse(
+					ctx,
+					userId,
+				)
+				if err != nil {
+					log.Printf("[ERROR] Unable to delete user %s: %s", userId, err)
+					continue
+				}
+
+				if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNotFound {
+					log.Printf("[ERROR] Unable to delete user %s, got status code %d: %s", userId, httpResp.StatusCode(), string(httpResp.Body))
+					continue
+				}
+
+				log.Printf("[INFO] Deleted user %s", userId)
+			}
+
+			return nil
+		},
+	})
+}
+
+// Adopting and then destroying a real user would remove them from the test
+// Organization, so users are only adopted from the fake API.
+func TestAccOrganizationUserResource(t *testing.T) {
+	rn := "anthropic_organization_user.test"
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix(t, "tf-user"))
+
+	if !acctest.TestFakeApi {
+		t.Skip("ANTHROPIC_TEST_FAKE_API must be set to adopt users")
+	}
+	userId := acctest.FakeApi.AddUser("Test User", email, "user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationUserDestroy(userId),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationUserResourceConfig(email, "user"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(userId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("email"), knownvalue.StringExact(email)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact("Test User")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("user")),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("added_at"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccOrganizationUserResourceConfig(email, "developer"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rn, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("developer")),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     email,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOrganizationUserResource_userId(t *testing.T) {
+	rn := "anthropic_organization_user.test"
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix(t, "tf-user"))
+
+	if !acctest.TestFakeApi {
+		t.Skip("ANTHROPIC_TEST_FAKE_API must be set to adopt users")
+	}
+	userId := acctest.FakeApi.AddUser("Test User", email, "developer")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationUserDestroy(userId),
+		Steps: []resource.TestStep{
+			{
+				// The role is changed as the user is adopted.
+				Config: testAccOrganizationUserResourceConfigUserId(userId, "billing"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.StringExact(userId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("email"), knownvalue.StringExact(email)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("billing")),
+				},
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOrganizationUserResource_notFound(t *testing.T) {
+	email := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix(t, "tf-user"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationUserResourceConfig(email, "user"),
+				ExpectError: regexp.MustCompile("User Not Found"),
+			},
+		},
+	})
+}
+
+func testAccOrganizationUserResourceConfig(email string, role string) string {
+	return fmt.Sprintf(`
+resource "anthropic_organization_user" "test" {
+	email = %[1]q
+	role  = %[2]q
+}
+`, email, role)
+}
+
+func testAccOrganizationUserResourceConfigUserId(userId string, role string) string {
+	return fmt.Sprintf(`
+resource "anthropic_organization_user" "test" {
+	user_id = %[1]q
+	role    = %[2]q
+}
+`, userId, role)
+}
+
+// testAccCheckOrganizationUserDestroy checks that destroying the resource
+// removed the user from the Organization.
+func testAccCheckOrganizationUserDestroy(userId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		httpResp, err := acctest.SharedClient.GetUserWithResponse(context.Background(), userId)
+		if err != nil {
+			return fmt.Errorf("Unable to read user, got error: %s", err)
+		}
+
+		if httpResp.StatusCode() != http.StatusNotFound {
+			return fmt.Errorf("User %s still exists, got status code %d", userId, httpResp.StatusCode())
+		}
+
+		return nil
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +21,8 @@ func NewWorkspaceResource() resource.Resource {
 
 var _ resource.Resource = &WorkspaceResource{}
 var _ resource.ResourceWithImportState = &WorkspaceResource{}
+var _ resource.ResourceWithIdentity = &WorkspaceResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceResource{}
 
 type WorkspaceResource struct {
 	baseResource
@@ -60,7 +64,20 @@ func (r *WorkspaceResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+func (r *WorkspaceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "ID of the Workspace.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_workspace", "create")
+
 	var data WorkspaceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -80,7 +97,14 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+			"Unable to create",
+			httpResp.HTTPResponse,
+			httpResp.Body,
+			map[string]path.Path{
+				"name": path.Root("name"),
+			},
+		))
 		return
 	}
 
@@ -95,6 +119,7 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -114,8 +139,13 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	if httpResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 
@@ -124,15 +154,29 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// Archived workspaces cannot be restored, so treat them as deleted and let
+	// Terraform plan a replacement.
+	if httpResp.JSON200.ArchivedAt != nil {
+		resp.Diagnostics.AddWarning(
+			"Workspace Archived",
+			fmt.Sprintf("Workspace %s was archived at %s and has been removed from the state. It will be recreated on the next apply.", httpResp.JSON200.Id, *httpResp.JSON200.ArchivedAt),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err := data.Fill(*httpResp.JSON200); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fill data: %s", err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_workspace", "update")
+
 	var data WorkspaceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -153,7 +197,14 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+			"Unable to update",
+			httpResp.HTTPResponse,
+			httpResp.Body,
+			map[string]path.Path{
+				"name": path.Root("name"),
+			},
+		))
 		return
 	}
 
@@ -168,9 +219,12 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_workspace", "delete")
+
 	var data WorkspaceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -187,12 +241,85 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to delete", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 }
 
 func (r *WorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// The import ID may be either a workspace ID or "name:<workspace name>".
+	name, ok := strings.CutPrefix(req.ID, "name:")
+	if !ok {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+
+	workspaces, err := r.findWorkspacesByName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.Append(NewClientErrorDiagnostic("Unable to read workspaces", err))
+		return
+	}
+
+	switch len(workspaces) {
+	case 0:
+		resp.Diagnostics.AddError("Workspace Not Found", fmt.Sprintf("No active workspace named %q exists in the Organization.", name))
+		return
+	case 1:
+	default:
+		ids := make([]string, len(workspaces))
+		for i, workspace := range workspaces {
+			ids[i] = workspace.Id
+		}
+		resp.Diagnostics.AddError("Multiple Workspaces Found", fmt.Sprintf("%d active workspaces are named %q: %s. Import the workspace by ID instead.", len(workspaces), name, strings.Join(ids, ", ")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), workspaces[0].Id)...)
+}
+
+// findWorkspacesByName returns the workspaces with the given name, excluding
+// archived workspaces.
+func (r *WorkspaceResource) findWorkspacesByName(ctx context.Context, name string) ([]apiclient.Workspace, error) {
+	params := &apiclient.ListWorkspacesParams{
+		IncludeArchived: new(false),
+		Limit:           new(100),
+	}
+
+	var workspaces []apiclient.Workspace
+	for {
+		httpResp, err := r.client.ListWorkspacesWithResponse(
+			ctx,
+			params,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if httpResp.StatusCode() != http.StatusOK {
+			return nil, apiclient.NewAPIError(httpResp.HTTPResponse, httpResp.Body)
+		}
+
+		if httpResp.JSON200 == nil {
+			return nil, fmt.Errorf("got empty response body")
+		}
+
+		for _, workspace := range httpResp.JSON200.Data {
+			if workspace.Name == name && workspace.ArchivedAt == nil {
+				workspaces = append(workspaces, workspace)
+			}
+		}
+
+		if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+			break
+		}
+
+		params.AfterId = httpResp.JSON200.LastId
+	}
+
+	return workspaces, nil
 }
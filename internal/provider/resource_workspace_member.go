@@ -8,7 +8,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
 )
@@ -19,6 +22,8 @@ func NewWorkspaceMemberResource() resource.Resource {
 
 var _ resource.Resource = &WorkspaceMemberResource{}
 var _ resource.ResourceWithImportState = &WorkspaceMemberResource{}
+var _ resource.ResourceWithIdentity = &WorkspaceMemberResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceMemberResource{}
 
 type WorkspaceMemberResource struct {
 	baseResource
@@ -36,10 +41,16 @@ func (r *WorkspaceMemberResource) Schema(ctx context.Context, req resource.Schem
 			"workspace_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the Workspace to which the member belongs.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"user_id": schema.StringAttribute{
 				MarkdownDescription: "ID of the user who is a member of the Workspace.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"workspace_role": schema.StringAttribute{
 				MarkdownDescription: "Role of the new Workspace Member. Must be one of `workspace_user`, `workspace_developer`, or `workspace_admin`.",
@@ -52,7 +63,24 @@ func (r *WorkspaceMemberResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+func (r *WorkspaceMemberResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"workspace_id": identityschema.StringAttribute{
+				Description:       "ID of the Workspace to which the member belongs.",
+				RequiredForImport: true,
+			},
+			"user_id": identityschema.StringAttribute{
+				Description:       "ID of the user who is a member of the Workspace.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *WorkspaceMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_workspace_member", "create")
+
 	var data WorkspaceMemberModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -74,7 +102,15 @@ func (r *WorkspaceMemberResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+			"Unable to create",
+			httpResp.HTTPResponse,
+			httpResp.Body,
+			map[string]path.Path{
+				"user_id":        path.Root("user_id"),
+				"workspace_role": path.Root("workspace_role"),
+			},
+		))
 		return
 	}
 
@@ -89,6 +125,7 @@ func (r *WorkspaceMemberResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *WorkspaceMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -109,8 +146,13 @@ func (r *WorkspaceMemberResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	if httpResp.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to read", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 
@@ -125,9 +167,12 @@ func (r *WorkspaceMemberResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *WorkspaceMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_workspace_member", "update")
+
 	var data WorkspaceMemberModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -149,7 +194,15 @@ func (r *WorkspaceMemberResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnosticWithAttributes(
+			"Unable to update",
+			httpResp.HTTPResponse,
+			httpResp.Body,
+			map[string]path.Path{
+				"user_id":        path.Root("user_id"),
+				"workspace_role": path.Root("workspace_role"),
+			},
+		))
 		return
 	}
 
@@ -164,9 +217,12 @@ func (r *WorkspaceMemberResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, data.Identity())...)
 }
 
 func (r *WorkspaceMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withAuditOperation(ctx, "anthropic_workspace_member", "delete")
+
 	var data WorkspaceMemberModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -184,17 +240,36 @@ func (r *WorkspaceMemberResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	if httpResp.StatusCode() == http.StatusNotFound {
+		return
+	}
+
 	if httpResp.StatusCode() != http.StatusOK {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body)))
+		resp.Diagnostics.Append(NewApiErrorDiagnostic("Unable to delete", httpResp.HTTPResponse, httpResp.Body))
 		return
 	}
 }
 
 func (r *WorkspaceMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	workspaceId, userId, err := SplitTwoPartId(req.ID, "workspace_id", "user_id")
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
-		return
+	var workspaceId, userId string
+
+	// Import blocks may give the resource identity instead of an import ID.
+	if req.ID == "" {
+		var identity WorkspaceMemberIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		workspaceId, userId = identity.WorkspaceId.ValueString(), identity.UserId.ValueString()
+	} else {
+		var err error
+		workspaceId, userId, err = SplitTwoPartId(req.ID, "workspace_id", "user_id")
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
+			return
+		}
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(
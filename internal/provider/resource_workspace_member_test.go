@@ -1,21 +1,124 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
+	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
 )
 
+func init() {
+	resource.AddTestSweepers("anthropic_workspace_member", &resource.Sweeper{
+		Name: "anthropic_workspace_member",
+		F: func(r string) error {
+			ctx := context.Background()
+
+			params := &apiclient.ListWorkspacesParams{
+				Limit: new(100),
+			}
+
+			var workspaceIds []string
+			for {
+				httpResp, err := acctest.SharedClient.ListWorkspacesWithResponse(
+					ctx,
+					params,
+				)
+				if err != nil {
+					return fmt.Errorf("Unable to read, got error: %s", err)
+				}
+
+				if httpResp.StatusCode() != http.StatusOK {
+					return fmt.Errorf("Unable to read, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+				}
+
+				for _, workspace := range httpResp.JSON200.Data {
+					if strings.HasPrefix(workspace.Name, "tf-") {
+						workspaceIds = append(workspaceIds, workspace.Id)
+					}
+				}
+
+				if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+					break
+				}
+
+				params.AfterId = httpResp.JSON200.LastId
+			}
+
+			for _, workspaceId := range workspaceIds {
+				// Members are collected before deleting any, so that the
+				// pagination cursor is not deleted from under the listing.
+				var userIds []string
+				membersParams := &apiclient.ListWorkspaceMembersParams{
+					Limit: new(100),
+				}
+				for {
+					httpResp, err := acctest.SharedClient.ListWorkspaceMembersWithResponse(
+						ctx,
+						workspaceId,
+						membersParams,
+					)
+					if err != nil {
+						return fmt.Errorf("Unable to read, got error: %s", err)
+					}
+
+					if httpResp.StatusCode() != http.StatusOK {
+						return fmt.Errorf("Unable to read, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+					}
+
+					for _, member := range httpResp.JSON200.Data {
+						userIds = append(userIds, member.UserId)
+					}
+
+					if !httpResp.JSON200.HasMore || httpResp.JSON200.LastId == nil {
+						break
+					}
+
+					membersParams.AfterId = httpResp.JSON200.LastId
+				}
+
+				for _, userId := range userIds {
+					log.Printf("[INFO] Destroying workspace member %s/%s", workspaceId, userId)
+
+					httpResp, err := acctest.SharedClient.DeleteWorkspaceMemberWithResponse(
+						ctx,
+						workspaceId,
+						userId,
+					)
+					if err != nil {
+						log.Printf("[ERROR] Unable to delete workspace member %s/%s: %s", workspaceId, userId, err)
+						continue
+					}
+
+					if httpResp.StatusCode() != http.StatusOK && httpResp.StatusCode() != http.StatusNotFound {
+						log.Printf("[ERROR] Unable to delete workspace member %s/%s, got status code %d: %s", workspaceId, userId, httpResp.StatusCode(), string(httpResp.Body))
+						continue
+					}
+
+					log.Printf("[INFO] Deleted workspace member %s/%s", workspaceId, userId)
+				}
+			}
+
+			return nil
+		},
+	})
+}
+
 func TestAccWorkspaceMemberResource(t *testing.T) {
 	rn := "anthropic_workspace_member.test"
-	workspaceName := acctest.RandomWithPrefix("tf-workspace")
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -54,6 +157,78 @@ func TestAccWorkspaceMemberResource(t *testing.T) {
 	})
 }
 
+func TestAccWorkspaceMemberResource_removed(t *testing.T) {
+	rn := "anthropic_workspace_member.test"
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
+
+	var workspaceId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceMemberResourceConfig(workspaceName, "workspace_user"),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return fmt.Errorf("not found: %s", rn)
+					}
+					workspaceId = rs.Primary.Attributes["workspace_id"]
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					httpResp, err := acctest.SharedClient.DeleteWorkspaceMemberWithResponse(context.Background(), workspaceId, acctest.TestUserId)
+					if err != nil {
+						t.Fatalf("Unable to delete workspace member, got error: %s", err)
+					}
+					if httpResp.StatusCode() != http.StatusOK {
+						t.Fatalf("Unable to delete workspace member, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+					}
+				},
+				Config: testAccWorkspaceMemberResourceConfig(workspaceName, "workspace_user"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rn, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccWorkspaceMemberResource_identity(t *testing.T) {
+	rn := "anthropic_workspace_member.test"
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceMemberResourceConfig(workspaceName, "workspace_user"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(rn, map[string]knownvalue.Check{
+						"workspace_id": knownvalue.NotNull(),
+						"user_id":      knownvalue.StringExact(acctest.TestUserId),
+					}),
+					statecheck.ExpectIdentityValueMatchesState(rn, tfjsonpath.New("workspace_id")),
+				},
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func testAccWorkspaceMemberResourceConfig(workspaceName string, workspaceRole string) string {
 	return fmt.Sprintf(`
 resource "anthropic_workspace" "test" {
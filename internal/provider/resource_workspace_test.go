@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jianyuan/terraform-provider-anthropic/internal/acctest"
 	"github.com/jianyuan/terraform-provider-anthropic/internal/apiclient"
 )
@@ -19,6 +23,10 @@ import (
 func init() {
 	resource.AddTestSweepers("anthropic_workspace", &resource.Sweeper{
 		Name: "anthropic_workspace",
+		// Memberships are removed before their workspaces are archived.
+		Dependencies: []string{
+			"anthropic_workspace_member",
+		},
 		F: func(r string) error {
 			ctx := context.Background()
 
@@ -73,7 +81,7 @@ func init() {
 
 func TestAccWorkspaceResource(t *testing.T) {
 	rn := "anthropic_workspace.test"
-	workspaceName := acctest.RandomWithPrefix("tf-workspace")
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -94,6 +102,18 @@ func TestAccWorkspaceResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateId:     "name:" + workspaceName,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  rn,
+				ImportState:   true,
+				ImportStateId: "name:" + workspaceName + "-missing",
+				ExpectError:   regexp.MustCompile("Workspace Not Found"),
+			},
 			{
 				Config: testAccWorkspaceResourceConfig(workspaceName + "-updated"),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -108,6 +128,87 @@ func TestAccWorkspaceResource(t *testing.T) {
 	})
 }
 
+func TestAccWorkspaceResource_archived(t *testing.T) {
+	rn := "anthropic_workspace.test"
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
+
+	var workspaceId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceResourceConfig(workspaceName),
+				Check: func(s *terraform.State) error {
+					rs, ok := s.RootModule().Resources[rn]
+					if !ok {
+						return fmt.Errorf("not found: %s", rn)
+					}
+					workspaceId = rs.Primary.ID
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					httpResp, err := acctest.SharedClient.ArchiveWorkspaceWithResponse(context.Background(), workspaceId)
+					if err != nil {
+						t.Fatalf("Unable to archive workspace, got error: %s", err)
+					}
+					if httpResp.StatusCode() != http.StatusOK {
+						t.Fatalf("Unable to archive workspace, got status code %d: %s", httpResp.StatusCode(), string(httpResp.Body))
+					}
+				},
+				Config: testAccWorkspaceResourceConfig(workspaceName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(rn, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(workspaceName)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("archived_at"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccWorkspaceResource_identity(t *testing.T) {
+	rn := "anthropic_workspace.test"
+	workspaceName := acctest.RandomWithPrefix(t, "tf-workspace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceResourceConfig(workspaceName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity(rn, map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState(rn, tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				ResourceName:    rn,
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+				ImportStateId:   "name:" + workspaceName,
+			},
+		},
+	})
+}
+
 func testAccWorkspaceResourceConfig(workspaceName string) string {
 	return fmt.Sprintf(`
 resource "anthropic_workspace" "test" {
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProviderRetryModel describes the retry block of the provider configuration.
+type ProviderRetryModel struct {
+	MaxAttempts   types.Int64   `tfsdk:"max_attempts"`
+	MinBackoff    types.String  `tfsdk:"min_backoff"`
+	MaxBackoff    types.String  `tfsdk:"max_backoff"`
+	RetryOnStatus []types.Int64 `tfsdk:"retry_on_status"`
+}
+
+const (
+	defaultRetryMaxAttempts = 10
+	defaultRetryMinBackoff  = 1 * time.Second
+	defaultRetryMaxBackoff  = 30 * time.Second
+
+	// statusOverloaded is returned by the Anthropic API when it is temporarily
+	// overloaded.
+	statusOverloaded = 529
+)
+
+var defaultRetryOnStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+	statusOverloaded,
+}
+
+// retryPolicy decides which requests are retried and how long to wait between
+// attempts.
+type retryPolicy struct {
+	MaxAttempts   int
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	RetryOnStatus map[int]bool
+}
+
+func newRetryPolicy(m *ProviderRetryModel) (retryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	p := retryPolicy{
+		MaxAttempts:   defaultRetryMaxAttempts,
+		MinBackoff:    defaultRetryMinBackoff,
+		MaxBackoff:    defaultRetryMaxBackoff,
+		RetryOnStatus: make(map[int]bool),
+	}
+	for _, code := range defaultRetryOnStatus {
+		p.RetryOnStatus[code] = true
+	}
+
+	if m == nil {
+		return p, diags
+	}
+
+	if !m.MaxAttempts.IsNull() && !m.MaxAttempts.IsUnknown() {
+		p.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+
+	parseBackoff := func(attr string, v types.String, dst *time.Duration) {
+		if v.IsNull() || v.IsUnknown() {
+			return
+		}
+
+		d, err := time.ParseDuration(v.ValueString())
+		if err != nil || d < 0 {
+			diags.AddAttributeError(
+				path.Root("retry").AtName(attr),
+				"Invalid Duration",
+				fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"2s\", got %q.", v.ValueString()),
+			)
+			return
+		}
+		*dst = d
+	}
+	parseBackoff("min_backoff", m.MinBackoff, &p.MinBackoff)
+	parseBackoff("max_backoff", m.MaxBackoff, &p.MaxBackoff)
+
+	if p.MinBackoff > p.MaxBackoff {
+		diags.AddAttributeError(
+			path.Root("retry").AtName("min_backoff"),
+			"Invalid Backoff",
+			fmt.Sprintf("min_backoff (%s) must not be greater than max_backoff (%s).", p.MinBackoff, p.MaxBackoff),
+		)
+	}
+
+	if m.RetryOnStatus != nil {
+		p.RetryOnStatus = make(map[int]bool, len(m.RetryOnStatus))
+		for _, code := range m.RetryOnStatus {
+			p.RetryOnStatus[int(code.ValueInt64())] = true
+		}
+	}
+
+	return p, diags
+}
+
+// Transport returns a transport that sends requests through next, retrying
+// them according to the policy. A nil next uses a pooled default transport.
+func (p retryPolicy) Transport(next http.RoundTripper) http.RoundTripper {
+	client := retryablehttp.NewClient()
+	if next != nil {
+		client.HTTPClient.Transport = next
+	}
+	client.ErrorHandler = retryablehttp.PassthroughErrorHandler
+	client.Logger = nil
+	client.RetryMax = p.MaxAttempts - 1
+	client.RetryWaitMin = p.MinBackoff
+	client.RetryWaitMax = p.MaxBackoff
+	client.CheckRetry = p.CheckRetry
+	client.Backoff = p.Backoff
+	client.RequestLogHook = func(_ retryablehttp.Logger, req *http.Request, attemptNum int) {
+		if attempt, ok := req.Context().Value(retryAttemptKey{}).(*int); ok {
+			*attempt = attemptNum + 1
+		}
+	}
+
+	return &retryTransport{
+		next: &retryablehttp.RoundTripper{Client: client},
+	}
+}
+
+// CheckRetry reports whether a request should be retried. Requests that are
+// not idempotent are only retried when the API could not have processed them:
+// when the connection could not be established, or when the API rejected the
+// request because of rate limiting or overload.
+func (p retryPolicy) CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	method, _ := ctx.Value(retryMethodKey{}).(string)
+	idempotent := isIdempotentMethod(method)
+
+	if err != nil {
+		// A request refused in read-only mode never will be accepted.
+		if errors.Is(err, errReadOnly) {
+			return false, err
+		}
+		if !idempotent && !isDialError(err) {
+			return false, nil
+		}
+		return retryablehttp.DefaultRetryPolicy(ctx, nil, err)
+	}
+
+	if !p.RetryOnStatus[resp.StatusCode] {
+		return false, nil
+	}
+
+	if !idempotent && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != statusOverloaded {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// Backoff returns how long to wait before the next attempt. The retry-after
+// header of rate limited and overloaded responses is honored exactly;
+// otherwise the wait grows exponentially from min to max.
+func (p retryPolicy) Backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == statusOverloaded) {
+		if d, ok := parseRetryAfter(resp.Header.Get("retry-after")); ok {
+			return d
+		}
+	}
+
+	wait := float64(min) * math.Pow(2, float64(attemptNum))
+	if wait > float64(max) {
+		return max
+	}
+	return time.Duration(wait)
+}
+
+// parseRetryAfter parses a retry-after header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseFloat(v, 64); err == nil {
+		if seconds < 0 {
+			return 0, true
+		}
+		return time.Duration(seconds * float64(time.Second)), true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDialError reports whether err happened before the request was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+type (
+	retryMethodKey  struct{}
+	retryAttemptKey struct{}
+)
+
+// retryAttemptFromContext returns the attempt number of the request, starting
+// at 1, or 0 if the request is not sent by the retry policy.
+func retryAttemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(retryAttemptKey{}).(*int); ok {
+		return *attempt
+	}
+	return 0
+}
+
+// retryTransport records the request method in the request context, since the
+// retry policy is not given the request itself, and tracks the attempt number
+// for the transports below it.
+type retryTransport struct {
+	next http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := context.WithValue(req.Context(), retryMethodKey{}, req.Method)
+	ctx = context.WithValue(ctx, retryAttemptKey{}, new(int))
+	return t.next.RoundTrip(req.WithContext(ctx))
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_CheckRetry(t *testing.T) {
+	p, diags := newRetryPolicy(nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	testCases := []struct {
+		name   string
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{"get server error", http.MethodGet, http.StatusInternalServerError, nil, true},
+		{"get bad request", http.MethodGet, http.StatusBadRequest, nil, false},
+		{"delete overloaded", http.MethodDelete, statusOverloaded, nil, true},
+		{"post rate limited", http.MethodPost, http.StatusTooManyRequests, nil, true},
+		{"post overloaded", http.MethodPost, statusOverloaded, nil, true},
+		{"post server error", http.MethodPost, http.StatusInternalServerError, nil, false},
+		{"post connection reset", http.MethodPost, 0, io.ErrUnexpectedEOF, false},
+		{"get connection reset", http.MethodGet, 0, io.ErrUnexpectedEOF, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), retryMethodKey{}, tc.method)
+
+			var resp *http.Response
+			if tc.err == nil {
+				resp = &http.Response{StatusCode: tc.status}
+			}
+
+			got, err := p.CheckRetry(ctx, resp, tc.err)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p, _ := newRetryPolicy(nil)
+
+	retryAfter := func(status int, v string) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Header:     http.Header{"Retry-After": []string{v}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		attempt int
+		resp    *http.Response
+		want    time.Duration
+	}{
+		{"first attempt", 0, nil, time.Second},
+		{"exponential", 3, nil, 8 * time.Second},
+		{"capped", 10, nil, 30 * time.Second},
+		{"rate limited", 0, retryAfter(http.StatusTooManyRequests, "45"), 45 * time.Second},
+		{"overloaded", 0, retryAfter(statusOverloaded, "2"), 2 * time.Second},
+		{"retry-after ignored on server error", 0, retryAfter(http.StatusInternalServerError, "45"), time.Second},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := p.Backoff(p.MinBackoff, p.MaxBackoff, tc.attempt, tc.resp)
+			if got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_Transport(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("retry-after", "0")
+			w.WriteHeader(statusOverloaded)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer srv.Close()
+
+	p, _ := newRetryPolicy(&ProviderRetryModel{})
+	client := &http.Client{Transport: p.Transport(nil)}
+
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{"name":"test"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != `{"name":"test"}` {
+		t.Errorf("got status %d and body %q", resp.StatusCode, body)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("got %d calls, want 2", got)
+	}
+}
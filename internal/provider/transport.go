@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// newHTTPTransport returns the transport that sends requests to the API,
+// configured with the provider's TLS and proxy settings.
+func newHTTPTransport(data AnthropicProviderModel) (*http.Transport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	var caCerts []byte
+	var caCertsAttr path.Path
+	if !data.CaCertPem.IsNull() {
+		caCerts = []byte(data.CaCertPem.ValueString())
+		caCertsAttr = path.Root("ca_cert_pem")
+	} else if !data.CaCertFile.IsNull() {
+		caCertsAttr = path.Root("ca_cert_file")
+
+		b, err := os.ReadFile(data.CaCertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(caCertsAttr, "Invalid CA Certificate", fmt.Sprintf("Unable to read CA certificate file: %s", err))
+			return nil, diags
+		}
+		caCerts = b
+	}
+
+	if caCerts != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(caCerts) {
+			diags.AddAttributeError(caCertsAttr, "Invalid CA Certificate", "No PEM encoded certificates were found.")
+			return nil, diags
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if !data.ClientCertPem.IsNull() || !data.ClientKeyPem.IsNull() {
+		cert, err := tls.X509KeyPair([]byte(data.ClientCertPem.ValueString()), []byte(data.ClientKeyPem.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_cert_pem"), "Invalid Client Certificate", fmt.Sprintf("Unable to load client certificate and key: %s", err))
+			return nil, diags
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if !data.ProxyUrl.IsNull() {
+		proxyUrl, err := url.Parse(data.ProxyUrl.ValueString())
+		if err != nil || proxyUrl.Scheme == "" || proxyUrl.Host == "" {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", fmt.Sprintf("Expected a URL such as \"http://proxy.example.com:3128\", got %q.", data.ProxyUrl.ValueString()))
+			return nil, diags
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	return transport, diags
+}
+
+// errReadOnly is returned for requests refused in read-only mode.
+var errReadOnly = errors.New("the provider is in read-only mode and only sends GET requests")
+
+// readOnlyTransport refuses every request that could change anything.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func newReadOnlyTransport(next http.RoundTripper) http.RoundTripper {
+	return &readOnlyTransport{
+		next: next,
+	}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("refusing %s %s: %w", req.Method, req.URL.Path, errReadOnly)
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// timeoutTransport limits the time taken by each attempt of a request,
+// including reading the response body.
+type timeoutTransport struct {
+	timeout time.Duration
+	next    http.RoundTripper
+}
+
+func newTimeoutTransport(timeout time.Duration, next http.RoundTripper) http.RoundTripper {
+	if timeout <= 0 {
+		return next
+	}
+
+	return &timeoutTransport{
+		timeout: timeout,
+		next:    next,
+	}
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelOnCloseBody{
+		ReadCloser: resp.Body,
+		cancel:     cancel,
+	}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNewHTTPTransport_caCertPem(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	caCertPem := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: srv.Certificate().Raw,
+	}))
+
+	testCases := []struct {
+		name    string
+		data    AnthropicProviderModel
+		wantErr bool
+	}{
+		{"untrusted", AnthropicProviderModel{}, true},
+		{"trusted", AnthropicProviderModel{CaCertPem: types.StringValue(caCertPem)}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transport, diags := newHTTPTransport(tc.data)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewHTTPTransport_invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		data AnthropicProviderModel
+	}{
+		{"ca_cert_pem", AnthropicProviderModel{CaCertPem: types.StringValue("not a certificate")}},
+		{"ca_cert_file", AnthropicProviderModel{CaCertFile: types.StringValue("testdata/does-not-exist.pem")}},
+		{"client_cert_pem", AnthropicProviderModel{ClientCertPem: types.StringValue("not a certificate"), ClientKeyPem: types.StringValue("not a key")}},
+		{"proxy_url", AnthropicProviderModel{ProxyUrl: types.StringValue("proxy.example.com")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, diags := newHTTPTransport(tc.data)
+			if !diags.HasError() {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestNewHTTPTransport_proxyUrl(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	transport, diags := newHTTPTransport(AnthropicProviderModel{ProxyUrl: types.StringValue(proxy.URL)})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://api.example.com/v1/organizations/me")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if proxied != "http://api.example.com/v1/organizations/me" {
+		t.Errorf("request was not sent through the proxy, got %q", proxied)
+	}
+}
+
+func TestTimeoutTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newTimeoutTransport(50*time.Millisecond, http.DefaultTransport)}
+
+	_, err := client.Get(srv.URL)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	var methods []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newReadOnlyTransport(http.DefaultTransport)}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodDelete} {
+		req, _ := http.NewRequest(method, srv.URL, nil)
+		if _, err := client.Do(req); !errors.Is(err, errReadOnly) {
+			t.Errorf("%s: got error %v, want %v", method, err, errReadOnly)
+		}
+	}
+
+	if len(methods) != 1 || methods[0] != http.MethodGet {
+		t.Errorf("got requests %v, want only GET", methods)
+	}
+}
+
+func TestReadOnlyTransport_retry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	var attempts int
+	p, _ := newRetryPolicy(&ProviderRetryModel{})
+	client := &http.Client{Transport: p.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return newReadOnlyTransport(http.DefaultTransport).RoundTrip(req)
+	}))}
+
+	req, _ := http.NewRequest(http.MethodDelete, srv.URL, nil)
+	if _, err := client.Do(req); !errors.Is(err, errReadOnly) {
+		t.Errorf("got error %v, want %v", err, errReadOnly)
+	}
+
+	if attempts != 1 {
+		t.Errorf("got %d attempts, want 1", attempts)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
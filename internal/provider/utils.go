@@ -3,6 +3,8 @@ package provider
 import (
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func BuildTwoPartId(a, b string) string {
@@ -16,3 +18,17 @@ func SplitTwoPartId(id, a, b string) (string, string, error) {
 	}
 	return parts[0], parts[1], nil
 }
+
+// ExpandStringList converts a list attribute into an optional query parameter,
+// returning nil when the list is empty.
+func ExpandStringList(values []types.String) *[]string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = v.ValueString()
+	}
+	return &out
+}
@@ -0,0 +1,416 @@
+// Package recorder records the API requests made by acceptance tests into
+// cassettes and replays them, so that tests can run without credentials
+// against real API responses.
+package recorder
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode of the recorder, set with the ANTHROPIC_TEST_CASSETTE_MODE
+// environment variable.
+type Mode string
+
+const (
+	ModeOff    Mode = ""
+	ModeRecord Mode = "record"
+	ModeReplay Mode = "replay"
+)
+
+// ModeEnvVar is the environment variable that switches the recorder on.
+const ModeEnvVar = "ANTHROPIC_TEST_CASSETTE_MODE"
+
+// ErrNoInteraction is returned when replaying a request that is not in the
+// cassette.
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// emailRegexp matches email addresses outside the example.com domain used by
+// the tests.
+var emailRegexp = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@(?:[A-Za-z0-9\-]+\.)*(?:[A-Za-z0-9\-]+)\.[A-Za-z]{2,}`)
+
+const redactedEmail = "redacted@example.com"
+
+// recordedResponseHeaders are the response headers kept in cassettes. Request
+// headers, which carry the API key, are never recorded.
+var recordedResponseHeaders = []string{
+	"Content-Type",
+	"Request-Id",
+	"Retry-After",
+}
+
+// ModeFromEnv returns the mode set in the environment.
+func ModeFromEnv() (Mode, error) {
+	switch mode := Mode(os.Getenv(ModeEnvVar)); mode {
+	case ModeOff, ModeRecord, ModeReplay:
+		return mode, nil
+	default:
+		return ModeOff, fmt.Errorf("%s must be %q or %q, got %q", ModeEnvVar, ModeRecord, ModeReplay, mode)
+	}
+}
+
+// Cassette is the file that stores the interactions of a test.
+type Cassette struct {
+	// Seed seeds the random values used by the test, so that replayed
+	// requests contain the same names as recorded ones.
+	Seed         uint64         `json:"seed"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	used bool
+}
+
+type Request struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       json.RawMessage   `json:"body,omitempty"`
+}
+
+// Session records or replays the interactions of a single test. While a
+// session is active, every transport returned by Transport uses it.
+type Session struct {
+	mode       Mode
+	path       string
+	redactions *strings.Replacer
+
+	mu        sync.Mutex
+	cassette  *Cassette
+	rand      *rand.Rand
+	unmatched []string
+
+	// replayed is the index after the last interaction replayed that is
+	// not a GET request.
+	replayed int
+}
+
+var (
+	activeMu sync.Mutex
+	active   *Session
+)
+
+// Start loads the cassette at path for replay, or creates a new cassette to be
+// written to path for recording, and makes it the active session.
+// Redactions map values, such as IDs of real objects, to the placeholders
+// stored in their place.
+func Start(path string, mode Mode, redactions map[string]string) (*Session, error) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+
+	if active != nil {
+		return nil, fmt.Errorf("a session for %s is already active; acceptance tests must not run in parallel while recording or replaying", active.path)
+	}
+
+	var oldnew []string
+	for value, placeholder := range redactions {
+		if value != "" {
+			oldnew = append(oldnew, value, placeholder)
+		}
+	}
+
+	s := &Session{
+		mode:       mode,
+		path:       path,
+		redactions: strings.NewReplacer(oldnew...),
+	}
+
+	switch mode {
+	case ModeRecord:
+		s.cassette = &Cassette{
+			Seed:         rand.Uint64(),
+			Interactions: []*Interaction{},
+		}
+	case ModeReplay:
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read cassette, record it with %s=%s: %w", ModeEnvVar, ModeRecord, err)
+		}
+		if err := json.Unmarshal(b, &s.cassette); err != nil {
+			return nil, fmt.Errorf("unable to decode cassette %s: %w", path, err)
+		}
+
+		// Bodies are indented in the file but compared compacted.
+		for _, interaction := range s.cassette.Interactions {
+			interaction.Request.Body = rawJSON(interaction.Request.Body)
+		}
+	default:
+		return nil, fmt.Errorf("invalid mode %q", mode)
+	}
+
+	s.rand = rand.New(rand.NewPCG(s.cassette.Seed, 0))
+	active = s
+
+	return s, nil
+}
+
+// Rand returns the random number generator for the values used by the test.
+func (s *Session) Rand() *rand.Rand {
+	return s.rand
+}
+
+// Stop deactivates the session and writes the cassette when recording. When
+// replaying, it returns an error if a request was not matched or a recorded
+// change was not replayed.
+func (s *Session) Stop() error {
+	activeMu.Lock()
+	if active == s {
+		active = nil
+	}
+	activeMu.Unlock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mode == ModeRecord {
+		b, err := json.MarshalIndent(s.cassette, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+			return err
+		}
+		return os.WriteFile(s.path, append(b, '\n'), 0o644)
+	}
+
+	var errs []error
+	for _, request := range s.unmatched {
+		errs = append(errs, fmt.Errorf("%s: %w", request, ErrNoInteraction))
+	}
+	for _, interaction := range s.cassette.Interactions {
+		if !interaction.used && interaction.Request.Method != http.MethodGet {
+			errs = append(errs, fmt.Errorf("recorded interaction %s %s was not replayed", interaction.Request.Method, interaction.Request.Path))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("cassette %s does not match the requests made: %w", s.path, errors.Join(errs...))
+	}
+	return nil
+}
+
+// Transport returns a transport that records requests sent through next, or
+// replays them without sending them, in the active session.
+func Transport(next http.RoundTripper) http.RoundTripper {
+	return &transport{
+		next: next,
+	}
+}
+
+type transport struct {
+	next http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	activeMu.Lock()
+	s := active
+	activeMu.Unlock()
+
+	if s == nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("%s is set but no cassette is active for %s %s", ModeEnvVar, req.Method, req.URL.Path)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		b, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+
+		clone := req.Clone(req.Context())
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+		req = clone
+	}
+
+	recorded := Request{
+		Method: req.Method,
+		Path:   s.redact(req.URL.Path),
+		Query:  s.redactQuery(req.URL.Query()),
+		Body:   rawJSON([]byte(s.redact(string(body)))),
+	}
+
+	if s.mode == ModeReplay {
+		return s.replay(req, recorded)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := &Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    make(map[string]string),
+			Body:       rawJSON([]byte(s.redact(string(respBody)))),
+		},
+	}
+	for _, name := range recordedResponseHeaders {
+		if v := resp.Header.Get(name); v != "" {
+			interaction.Response.Headers[name] = v
+		}
+	}
+	for name := range resp.Header {
+		if strings.HasPrefix(strings.ToLower(name), "anthropic-ratelimit-") {
+			interaction.Response.Headers[name] = resp.Header.Get(name)
+		}
+	}
+
+	s.mu.Lock()
+	s.cassette.Interactions = append(s.cassette.Interactions, interaction)
+	s.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay returns the response of the first interaction not yet replayed that
+// matches the request's method, path, query and body. The number of reads
+// made by Terraform varies between versions, so GET requests only match
+// interactions recorded after the last change replayed, and are answered with
+// the last matching response once those are used up.
+func (s *Session) replay(req *http.Request, recorded Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var start int
+	if recorded.Method == http.MethodGet {
+		start = s.replayed
+	}
+
+	for i, interaction := range s.cassette.Interactions[start:] {
+		if interaction.used || !interaction.Request.matches(recorded) {
+			continue
+		}
+		interaction.used = true
+
+		if recorded.Method != http.MethodGet {
+			s.replayed = max(s.replayed, start+i+1)
+		}
+
+		return interaction.Response.httpResponse(req), nil
+	}
+
+	if recorded.Method == http.MethodGet {
+		for _, interaction := range slices.Backward(s.cassette.Interactions) {
+			if interaction.used && interaction.Request.matches(recorded) {
+				return interaction.Response.httpResponse(req), nil
+			}
+		}
+	}
+
+	request := fmt.Sprintf("%s %s", recorded.Method, recorded.Path)
+	if recorded.Query != "" {
+		request += "?" + recorded.Query
+	}
+	if len(recorded.Body) > 0 {
+		request += " " + string(recorded.Body)
+	}
+	s.unmatched = append(s.unmatched, request)
+
+	return nil, fmt.Errorf("replaying %s: %w", request, ErrNoInteraction)
+}
+
+func (r Response) httpResponse(req *http.Request) *http.Response {
+	header := make(http.Header)
+	for name, v := range r.Headers {
+		header.Set(name, v)
+	}
+	body := responseBody(r.Body)
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func (r Request) matches(other Request) bool {
+	return r.Method == other.Method &&
+		r.Path == other.Path &&
+		r.Query == other.Query &&
+		bytes.Equal(r.Body, other.Body)
+}
+
+func (s *Session) redact(v string) string {
+	return emailRegexp.ReplaceAllStringFunc(s.redactions.Replace(v), func(email string) string {
+		if strings.HasSuffix(strings.ToLower(email), "@example.com") {
+			return email
+		}
+		return redactedEmail
+	})
+}
+
+// redactQuery returns the redacted query in a canonical order.
+func (s *Session) redactQuery(query url.Values) string {
+	redacted := make(url.Values, len(query))
+	for name, values := range query {
+		for _, v := range values {
+			redacted.Add(name, s.redact(v))
+		}
+	}
+	return redacted.Encode()
+}
+
+// rawJSON returns b compacted if it is JSON, or as a JSON string otherwise.
+func rawJSON(b []byte) json.RawMessage {
+	if len(b) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, b); err == nil {
+		return buf.Bytes()
+	}
+
+	s, _ := json.Marshal(string(b))
+	return s
+}
+
+// responseBody reverses rawJSON.
+func responseBody(raw json.RawMessage) []byte {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return []byte(s)
+	}
+	return raw
+}
@@ -0,0 +1,156 @@
+package recorder
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSession(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("request-id", "req_test")
+		w.Header().Set("anthropic-ratelimit-requests-remaining", "99")
+		io.WriteString(w, `{"id":"user_real","email":"jane@corp.test","added_at":"2025-01-01T00:00:00Z"}`)
+	}))
+	defer srv.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "TestSession.json")
+	redactions := map[string]string{"user_real": "user_test"}
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+
+	s, err := Start(path, ModeRecord, redactions)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	seed := s.Rand().Int()
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL+"/v1/organizations/users/user_real?b=2&a=1", strings.NewReader(`{"role": "developer"}`))
+	req.Header.Set("x-api-key", "sk-ant-admin01-secret")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "jane@corp.test") {
+		t.Errorf("recording changed the response body: %s", body)
+	}
+
+	if err := s.Stop(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, secret := range []string{"user_real", "jane@corp.test", "sk-ant-admin01-secret"} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("cassette contains %q: %s", secret, b)
+		}
+	}
+
+	s, err = Start(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := s.Rand().Int(); got != seed {
+		t.Errorf("got random value %d, want %d", got, seed)
+	}
+
+	req, _ = http.NewRequest(http.MethodPost, srv.URL+"/v1/organizations/users/user_test?a=1&b=2", strings.NewReader(`{"role":"developer"}`))
+	resp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("request-id") != "req_test" || resp.Header.Get("anthropic-ratelimit-requests-remaining") != "99" {
+		t.Errorf("unexpected response: %d %v", resp.StatusCode, resp.Header)
+	}
+	if !strings.Contains(string(body), `"user_test"`) || !strings.Contains(string(body), "redacted@example.com") {
+		t.Errorf("unexpected response body: %s", body)
+	}
+
+	req, _ = http.NewRequest(http.MethodPost, srv.URL+"/v1/organizations/users/user_test?a=1&b=2", strings.NewReader(`{"role":"developer"}`))
+	if _, err := client.Do(req); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("got error %v for a request replayed twice, want %v", err, ErrNoInteraction)
+	}
+
+	if err := s.Stop(); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("got error %v, want %v", err, ErrNoInteraction)
+	}
+
+	if requests != 1 {
+		t.Errorf("got %d requests to the server, want 1", requests)
+	}
+}
+
+func TestTransport_noSession(t *testing.T) {
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+
+	if _, err := client.Get("http://api.example.com/v1/organizations/me"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestSession_replayReads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "TestSession_replayReads.json")
+	cassette := `{
+  "seed": 1,
+  "interactions": [
+    {"request": {"method": "GET", "path": "/v1/organizations/workspaces/wrkspc_test"}, "response": {"status_code": 200, "body": {"name":"before"}}},
+    {"request": {"method": "GET", "path": "/v1/organizations/workspaces/wrkspc_test"}, "response": {"status_code": 200, "body": {"name":"before"}}},
+    {"request": {"method": "POST", "path": "/v1/organizations/workspaces/wrkspc_test", "body": {"name": "after"}}, "response": {"status_code": 200, "body": {"name":"after"}}},
+    {"request": {"method": "GET", "path": "/v1/organizations/workspaces/wrkspc_test"}, "response": {"status_code": 200, "body": {"name":"after"}}}
+  ]
+}
+`
+	if err := os.WriteFile(path, []byte(cassette), 0o644); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	s, err := Start(path, ModeReplay, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+	url := "http://api.example.com/v1/organizations/workspaces/wrkspc_test"
+
+	// Fewer reads than recorded before the change, and more after it.
+	steps := []struct {
+		method string
+		body   string
+		want   string
+	}{
+		{http.MethodGet, "", `{"name":"before"}`},
+		{http.MethodPost, `{"name":"after"}`, `{"name":"after"}`},
+		{http.MethodGet, "", `{"name":"after"}`},
+		{http.MethodGet, "", `{"name":"after"}`},
+	}
+	for _, step := range steps {
+		req, _ := http.NewRequest(step.method, url, strings.NewReader(step.body))
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", step.method, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != step.want {
+			t.Errorf("%s: got body %s, want %s", step.method, body, step.want)
+		}
+	}
+
+	if err := s.Stop(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}